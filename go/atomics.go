@@ -0,0 +1,336 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func atomics() {
+	printAtomicHeader("GO ATOMICS AND LOCK-FREE COUNTERS TUTORIAL")
+
+	// Section 1: Why counter++ Is Not Safe
+	printAtomicSection("1. Why counter++ Is Not Safe")
+	fmt.Printf("   counter++ looks like one step but is three:\n\n")
+	fmt.Printf("      tmp := counter    // 1. read\n")
+	fmt.Printf("      tmp = tmp + 1     // 2. modify\n")
+	fmt.Printf("      counter = tmp     // 3. write\n\n")
+	fmt.Printf("   Two goroutines can both read 41 and both write 42 - one\n")
+	fmt.Printf("   increment is lost. That is a data race: go run -race reports it.\n")
+	fmt.Printf("   💡 An atomic operation does all three steps as one indivisible\n")
+	fmt.Printf("      CPU instruction, so no other goroutine can see it half done\n\n")
+
+	// Section 2: Typed Atomics
+	printAtomicSection("2. Typed Atomics")
+	fmt.Printf("   ┌──────────────────────┬────────────────────────────────────────────┐\n")
+	fmt.Printf("   │ Type                 │ Methods                                    │\n")
+	fmt.Printf("   ├──────────────────────┼────────────────────────────────────────────┤\n")
+	fmt.Printf("   │ atomic.Int32 / Int64 │ Load Store Add Swap CompareAndSwap And Or  │\n")
+	fmt.Printf("   │ atomic.Uint32 / 64   │ same as Int32 / Int64                      │\n")
+	fmt.Printf("   │ atomic.Bool          │ Load Store Swap CompareAndSwap             │\n")
+	fmt.Printf("   │ atomic.Pointer[T]    │ Load Store Swap CompareAndSwap             │\n")
+	fmt.Printf("   │ atomic.Value         │ Load Store Swap CompareAndSwap (any type)  │\n")
+	fmt.Printf("   └──────────────────────┴────────────────────────────────────────────┘\n\n")
+	fmt.Printf("   var hits atomic.Int64   // the zero value is ready to use\n")
+	fmt.Printf("   for range 8 {\n")
+	fmt.Printf("       wg.Go(func() {\n")
+	fmt.Printf("           for range 10_000 {\n")
+	fmt.Printf("               hits.Add(1)\n")
+	fmt.Printf("           }\n")
+	fmt.Printf("       })\n")
+	fmt.Printf("   }\n\n")
+	var hits atomic.Int64
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() {
+			for range 10_000 {
+				hits.Add(1)
+			}
+		})
+	}
+	wg.Wait()
+	fmt.Printf("   hits.Load()               → %d   ✅ no increment lost\n", hits.Load())
+	fmt.Printf("   hits.Swap(0)              → %d   (returns the old value)\n", hits.Swap(0))
+	fmt.Printf("   hits.CompareAndSwap(0, 7) → %t\n", hits.CompareAndSwap(0, 7))
+	fmt.Printf("   hits.CompareAndSwap(0, 9) → %t  (current value is %d, not 0)\n\n", hits.CompareAndSwap(0, 9), hits.Load())
+	var ready atomic.Bool
+	fmt.Printf("   var ready atomic.Bool\n")
+	fmt.Printf("   ready.CompareAndSwap(false, true) → %t   first caller wins\n", ready.CompareAndSwap(false, true))
+	fmt.Printf("   ready.CompareAndSwap(false, true) → %t  everyone after loses\n", ready.CompareAndSwap(false, true))
+	fmt.Printf("   ⚠️  Never copy an atomic after first use - go vet's copylocks\n")
+	fmt.Printf("      check flags it, just like copying a sync.Mutex\n\n")
+
+	// Section 3: Compare-and-Swap Loops
+	printAtomicSection("3. Compare-and-Swap Loops")
+	fmt.Printf("   Add covers +=. Anything else (max, capped increment, ...) uses\n")
+	fmt.Printf("   the CAS loop: read, compute, and retry if someone got there first.\n\n")
+	fmt.Printf("   func (s *slotPool) tryAcquire() bool {\n")
+	fmt.Printf("       for {\n")
+	fmt.Printf("           used := s.used.Load()\n")
+	fmt.Printf("           if used >= s.limit {\n")
+	fmt.Printf("               return false          // full: give up, no retry\n")
+	fmt.Printf("           }\n")
+	fmt.Printf("           if s.used.CompareAndSwap(used, used+1) {\n")
+	fmt.Printf("               return true           // our increment won\n")
+	fmt.Printf("           }\n")
+	fmt.Printf("           // another goroutine changed used; read it again\n")
+	fmt.Printf("       }\n")
+	fmt.Printf("   }\n\n")
+	pool := &slotPool{limit: 5}
+	var granted atomic.Int32
+	start := make(chan struct{})
+	for range 20 {
+		wg.Go(func() {
+			<-start
+			if pool.tryAcquire() {
+				granted.Add(1)
+			}
+		})
+	}
+	close(start)
+	wg.Wait()
+	fmt.Printf("   20 goroutines race for 5 slots:\n")
+	fmt.Printf("   granted = %d, used = %d, CAS retries = %d\n", granted.Load(), pool.used.Load(), pool.retries.Load())
+	if pool.retries.Load() == 0 {
+		fmt.Printf("   (no retries this time: with GOMAXPROCS=%d the goroutines rarely\n", runtime.GOMAXPROCS(0))
+		fmt.Printf("    interleave between the Load and the CompareAndSwap)\n")
+	}
+	fmt.Printf("   💡 The Concurrency Patterns semaphore demo tracks its peak\n")
+	fmt.Printf("      with the same loop: a CAS-based atomic max\n\n")
+
+	// Section 4: atomic.Pointer[T]
+	printAtomicSection("4. Swapping Snapshots with atomic.Pointer[T]")
+	fmt.Printf("   Readers Load a pointer to an immutable config; the writer builds\n")
+	fmt.Printf("   a new one and Stores it. No reader ever sees a half-updated value.\n\n")
+	var current atomic.Pointer[serviceConfig]
+	current.Store(&serviceConfig{version: 1, timeout: time.Second, endpoints: []string{"eu-1"}})
+	fmt.Printf("   var current atomic.Pointer[serviceConfig]\n")
+	fmt.Printf("   current.Store(&serviceConfig{version: 1, ...})\n\n")
+	seen := make([]map[int]int, 3)
+	stop := make(chan struct{})
+	var readers sync.WaitGroup
+	for r := range seen {
+		seen[r] = make(map[int]int)
+		readers.Go(func() {
+			for {
+				cfg := current.Load()
+				if len(cfg.endpoints) != cfg.version {
+					seen[r][-1]++ // a torn snapshot; never happens
+				}
+				seen[r][cfg.version]++
+				select {
+				case <-stop:
+					return
+				default:
+					runtime.Gosched()
+				}
+			}
+		})
+	}
+	for version := 2; version <= 4; version++ {
+		time.Sleep(2 * time.Millisecond)
+		old := current.Load()
+		next := &serviceConfig{
+			version:   version,
+			timeout:   old.timeout + time.Second,
+			endpoints: append(append([]string(nil), old.endpoints...), fmt.Sprintf("eu-%d", version)),
+		}
+		current.Store(next)
+	}
+	time.Sleep(2 * time.Millisecond)
+	close(stop)
+	readers.Wait()
+	for r, versions := range seen {
+		fmt.Printf("   reader %d saw versions:", r+1)
+		for version := 1; version <= 4; version++ {
+			if versions[version] > 0 {
+				fmt.Printf(" v%d", version)
+			}
+		}
+		if versions[-1] > 0 {
+			fmt.Printf("   ❌ %d torn reads", versions[-1])
+		}
+		fmt.Println()
+	}
+	cfg := current.Load()
+	fmt.Printf("   final: v%d, timeout %v, endpoints %v\n", cfg.version, cfg.timeout, cfg.endpoints)
+	fmt.Printf("   💡 Copy-on-write: never modify a config after Store - build a new one\n\n")
+
+	// Section 5: atomic.Value
+	printAtomicSection("5. atomic.Value (the Pre-Generics Version)")
+	var settings atomic.Value
+	settings.Store(map[string]string{"theme": "dark"})
+	theme := settings.Load().(map[string]string)["theme"]
+	fmt.Printf("   var settings atomic.Value\n")
+	fmt.Printf("   settings.Store(map[string]string{\"theme\": \"dark\"})\n")
+	fmt.Printf("   settings.Load().(map[string]string)[\"theme\"] → %q\n\n", theme)
+	fmt.Printf("   settings.Store(\"light\")   // a different concrete type\n")
+	if recovered, _ := capturePanic(func() { settings.Store("light") }); recovered != nil {
+		fmt.Printf("   ❌ panic: %v\n", recovered)
+	}
+	fmt.Printf("   ✅ Prefer atomic.Pointer[T]: the type is checked at compile time\n")
+	fmt.Printf("      and Load needs no type assertion\n\n")
+
+	// Section 6: Mutex vs Atomic Benchmark
+	printAtomicSection("6. Mutex vs Atomic: testing.Benchmark")
+	fmt.Printf("   testing.Benchmark runs a benchmark function inside any program -\n")
+	fmt.Printf("   no go test needed (the Testing tutorial shows the go test way).\n\n")
+	fmt.Printf("   result := testing.Benchmark(func(b *testing.B) {\n")
+	fmt.Printf("       b.RunParallel(func(pb *testing.PB) {\n")
+	fmt.Printf("           for pb.Next() {\n")
+	fmt.Printf("               counter.Add(1)\n")
+	fmt.Printf("           }\n")
+	fmt.Printf("       })\n")
+	fmt.Printf("   })\n\n")
+	fmt.Printf("   Running 4 benchmarks (about a second)...\n\n")
+	results := runCounterBenchmarks()
+	baseline := nsPerOp(results[0].result)
+	fmt.Printf("   ┌──────────────────────────┬──────────────┬──────────────┬──────────┐\n")
+	fmt.Printf("   │ Benchmark                │ Iterations   │ ns/op        │ vs mutex │\n")
+	fmt.Printf("   ├──────────────────────────┼──────────────┼──────────────┼──────────┤\n")
+	for _, r := range results {
+		fmt.Printf("   │ %-24s │ %-12d │ %-12s │ %-8s │\n", r.name, r.result.N,
+			fmt.Sprintf("%.2f", nsPerOp(r.result)), fmt.Sprintf("%.1f×", baseline/nsPerOp(r.result)))
+	}
+	fmt.Printf("   └──────────────────────────┴──────────────┴──────────────┴──────────┘\n")
+	fmt.Printf("   Measured with GOMAXPROCS=%d\n", runtime.GOMAXPROCS(0))
+	if runtime.GOMAXPROCS(0) == 1 {
+		fmt.Printf("   ⚠️  With one P the parallel runs never truly contend; on a\n")
+		fmt.Printf("      multi-core machine the mutex falls much further behind\n")
+	}
+	fmt.Printf("   💡 An uncontended Lock/Unlock is itself a couple of atomic ops -\n")
+	fmt.Printf("      mutexes get expensive when goroutines have to wait for them\n\n")
+
+	// Section 7: Choosing Between Atomics and Mutexes
+	printAtomicSection("7. Choosing Between Atomics and Mutexes")
+	fmt.Printf("   ┌──────────────────────────────────┬──────────────────────────┐\n")
+	fmt.Printf("   │ Situation                        │ Reach for                │\n")
+	fmt.Printf("   ├──────────────────────────────────┼──────────────────────────┤\n")
+	fmt.Printf("   │ Counter, gauge, statistics       │ atomic.Int64 .Add        │\n")
+	fmt.Printf("   │ One-time flag, \"closed\" state    │ atomic.Bool CAS          │\n")
+	fmt.Printf("   │ Read-mostly config snapshot      │ atomic.Pointer[T]        │\n")
+	fmt.Printf("   │ Several fields that must agree   │ sync.Mutex               │\n")
+	fmt.Printf("   │ Maps, slices, multi-step updates │ sync.Mutex / RWMutex     │\n")
+	fmt.Printf("   │ Handing work between goroutines  │ channels                 │\n")
+	fmt.Printf("   └──────────────────────────────────┴──────────────────────────┘\n")
+	fmt.Printf("   ⚠️  Two atomics are not one transaction: updating a and b with\n")
+	fmt.Printf("      separate atomic calls lets readers see a new a with an old b\n\n")
+
+	printAtomicFooter()
+}
+
+// Types for demonstrations
+
+type slotPool struct {
+	used    atomic.Int32
+	retries atomic.Int32
+	limit   int32
+}
+
+type serviceConfig struct {
+	version   int
+	timeout   time.Duration
+	endpoints []string
+}
+
+type mutexCounter struct {
+	mu sync.Mutex
+	n  int64
+}
+
+type counterBenchmark struct {
+	name   string
+	result testing.BenchmarkResult
+}
+
+// Helper functions for demonstrations
+
+func (s *slotPool) tryAcquire() bool {
+	for {
+		used := s.used.Load()
+		if used >= s.limit {
+			return false
+		}
+		if s.used.CompareAndSwap(used, used+1) {
+			return true
+		}
+		s.retries.Add(1)
+	}
+}
+
+func (c *mutexCounter) inc() {
+	c.mu.Lock()
+	c.n++
+	c.mu.Unlock()
+}
+
+// runCounterBenchmarks registers the testing flags (go test normally does
+// this) so each benchmark can run for 200ms instead of the default second.
+func runCounterBenchmarks() []counterBenchmark {
+	testing.Init()
+	flag.Set("test.benchtime", "200ms")
+
+	var locked mutexCounter
+	var lockFree atomic.Int64
+	return []counterBenchmark{
+		{"mutex, 1 goroutine", testing.Benchmark(func(b *testing.B) {
+			for b.Loop() {
+				locked.inc()
+			}
+		})},
+		{"atomic, 1 goroutine", testing.Benchmark(func(b *testing.B) {
+			for b.Loop() {
+				lockFree.Add(1)
+			}
+		})},
+		{"mutex, RunParallel", testing.Benchmark(func(b *testing.B) {
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					locked.inc()
+				}
+			})
+		})},
+		{"atomic, RunParallel", testing.Benchmark(func(b *testing.B) {
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					lockFree.Add(1)
+				}
+			})
+		})},
+	}
+}
+
+// nsPerOp keeps the fraction that BenchmarkResult.NsPerOp truncates away.
+func nsPerOp(r testing.BenchmarkResult) float64 {
+	return float64(r.T.Nanoseconds()) / float64(max(r.N, 1))
+}
+
+// Print helper functions
+
+func printAtomicHeader(title string) {
+	fmt.Println("\n" + strings.Repeat("=", 60))
+	fmt.Printf("  %s\n", title)
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
+
+func printAtomicSection(title string) {
+	fmt.Printf("┌─ %s\n", title)
+	fmt.Println("│")
+}
+
+func printAtomicFooter() {
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println("  ✅ Tutorial Complete!")
+	fmt.Println("  💡 Key Takeaways:")
+	fmt.Println("     • counter++ is a read-modify-write race without sync")
+	fmt.Println("     • Typed atomics (Int64, Bool, Pointer[T]) have ready zero values")
+	fmt.Println("     • CAS loops build any single-value update lock-free")
+	fmt.Println("     • atomic.Pointer[T] swaps immutable snapshots safely")
+	fmt.Println("     • Atomics for single values; mutexes for invariants")
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
@@ -0,0 +1,321 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+)
+
+func closures() {
+	printClosureHeader("GO CLOSURES AND LOOP VARIABLES TUTORIAL")
+
+	// Section 1: Capturing Variables
+	printClosureSection("1. Closures Capture Variables, Not Values")
+	fmt.Printf("   x := 1\n")
+	fmt.Printf("   show := func() int { return x }\n")
+	fmt.Printf("   x = 2\n")
+	x := 1
+	show := func() int { return x }
+	x = 2
+	fmt.Printf("   show() = %d   // sees the CURRENT x, not the x at creation\n\n", show())
+
+	fmt.Printf("   Two closures over one variable share it:\n")
+	fmt.Printf("   count := 0\n")
+	fmt.Printf("   inc := func() { count++ }\n")
+	fmt.Printf("   get := func() int { return count }\n")
+	count := 0
+	inc := func() { count++ }
+	get := func() int { return count }
+	inc()
+	inc()
+	inc()
+	fmt.Printf("   inc(); inc(); inc() → get() = %d\n", get())
+	fmt.Printf("   💡 This is the 'by reference' capture from the Defer tutorial's\n")
+	fmt.Printf("      anonymousDeferExample - x was 20 when the deferred func ran\n\n")
+
+	// Section 2: Each Call Makes a New Environment
+	printClosureSection("2. Each Call Creates a Fresh Captured Variable")
+	fmt.Printf("   func makeCounter() func() int {\n")
+	fmt.Printf("       n := 0                 // a new n per makeCounter call\n")
+	fmt.Printf("       return func() int {\n")
+	fmt.Printf("           n++\n")
+	fmt.Printf("           return n\n")
+	fmt.Printf("       }\n")
+	fmt.Printf("   }\n\n")
+	first, second := makeCounter(), makeCounter()
+	fmt.Printf("   first := makeCounter(); second := makeCounter()\n")
+	fmt.Printf("   first()  = %d\n", first())
+	fmt.Printf("   first()  = %d\n", first())
+	fmt.Printf("   second() = %d   // independent of first\n", second())
+	fmt.Printf("   multiplier(2)(5) = %d, multiplier(3)(5) = %d   // same idea, factor captured\n\n", multiplier(2)(5), multiplier(3)(5))
+
+	// Section 3: Per-Iteration Loop Variables
+	printClosureSection("3. Go 1.22+: A New Loop Variable Every Iteration")
+	fmt.Printf("   var funcs []func() int\n")
+	fmt.Printf("   for i := 0; i < 3; i++ {\n")
+	fmt.Printf("       funcs = append(funcs, func() int { return i })\n")
+	fmt.Printf("   }\n\n")
+	var funcs []func() int
+	var addresses []string
+	for i := 0; i < 3; i++ {
+		funcs = append(funcs, func() int { return i })
+		addresses = append(addresses, fmt.Sprintf("%p", &i))
+	}
+	fmt.Printf("   Calling them: ")
+	for _, f := range funcs {
+		fmt.Printf("%d ", f())
+	}
+	fmt.Printf("\n   &i per iteration: %s\n", strings.Join(addresses, " "))
+	fmt.Printf("   💡 Each iteration has its own i (different addresses), so each\n")
+	fmt.Printf("      closure keeps the value from its own iteration\n\n")
+
+	// Section 4: Goroutines
+	printClosureSection("4. Goroutines Capturing Loop Variables")
+	fmt.Printf("   for _, name := range []string{\"ann\", \"ben\", \"cy\"} {\n")
+	fmt.Printf("       wg.Go(func() { record(name) })   // no name := name needed\n")
+	fmt.Printf("   }\n\n")
+	fmt.Printf("   Recorded (sorted): %v\n", captureInGoroutines([]string{"ann", "ben", "cy"}))
+	fmt.Printf("   💡 Before Go 1.22 you had to write name := name inside the loop or\n")
+	fmt.Printf("      pass name as an argument - now the loop does it for you\n\n")
+
+	// Section 5: The Old Behavior
+	printClosureSection("5. Reproducing the Pre-1.22 Behavior")
+	fmt.Printf("   Loop semantics follow the 'go' line in go.mod, so the same code\n")
+	fmt.Printf("   is built twice in scratch modules with different directives:\n\n")
+	printScratchFile(scratchFile{"main.go", loopVarSource})
+	runLoopVarComparison()
+
+	// Section 6: Generators
+	printClosureSection("6. Closures as Generators")
+	fmt.Printf("   func fibonacciGenerator() func() int {\n")
+	fmt.Printf("       a, b := 0, 1\n")
+	fmt.Printf("       return func() int {\n")
+	fmt.Printf("           result := a\n")
+	fmt.Printf("           a, b = b, a+b\n")
+	fmt.Printf("           return result\n")
+	fmt.Printf("       }\n")
+	fmt.Printf("   }\n\n")
+	next := fibonacciGenerator()
+	fmt.Printf("   next := fibonacciGenerator()\n")
+	fmt.Printf("   next() × 10 →")
+	for range 10 {
+		fmt.Printf(" %d", next())
+	}
+	fmt.Println()
+	ticket := idGenerator("TICKET")
+	order := idGenerator("ORDER")
+	fmt.Printf("   idGenerator(\"TICKET\") → %s, %s, %s\n", ticket(), ticket(), ticket())
+	fmt.Printf("   idGenerator(\"ORDER\")  → %s, %s\n", order(), order())
+	fmt.Printf("   💡 The Iterators tutorial's fibonacci() is the push-style version\n")
+	fmt.Printf("      of the same idea\n\n")
+
+	// Section 7: State Machines
+	printClosureSection("7. Closures as a State Machine")
+	fmt.Printf("   Each state is a name plus a closure that handles an event and\n")
+	fmt.Printf("   returns the next state - a coin-operated turnstile:\n\n")
+	fmt.Printf("   type state struct {\n")
+	fmt.Printf("       name string\n")
+	fmt.Printf("       on   func(event string) (next *state, action string)\n")
+	fmt.Printf("   }\n\n")
+	fmt.Printf("   ┌──────────┬────────┬───────────┬──────────────────────────┐\n")
+	fmt.Printf("   │ State    │ Event  │ Next      │ Action                   │\n")
+	fmt.Printf("   ├──────────┼────────┼───────────┼──────────────────────────┤\n")
+	machine := newTurnstile()
+	for _, event := range []string{"push", "coin", "coin", "push", "push", "coin", "kick"} {
+		from := machine.state()
+		action := machine.handle(event)
+		fmt.Printf("   │ %-8s │ %-6s │ %-9s │ %-24s │\n", from, event, machine.state(), action)
+	}
+	fmt.Printf("   └──────────┴────────┴───────────┴──────────────────────────┘\n")
+	fmt.Printf("   coins collected: %d (the count lives in a captured variable)\n", machine.coins())
+	fmt.Printf("   💡 No switch on a state enum - each state carries its own handler\n\n")
+
+	printClosureFooter()
+}
+
+// Helper functions for demonstrations
+
+func makeCounter() func() int {
+	n := 0
+	return func() int {
+		n++
+		return n
+	}
+}
+
+func captureInGoroutines(names []string) []string {
+	var mu sync.Mutex
+	var recorded []string
+	var wg sync.WaitGroup
+	for _, name := range names {
+		wg.Go(func() {
+			mu.Lock()
+			defer mu.Unlock()
+			recorded = append(recorded, name)
+		})
+	}
+	wg.Wait()
+	sort.Strings(recorded)
+	return recorded
+}
+
+func fibonacciGenerator() func() int {
+	a, b := 0, 1
+	return func() int {
+		result := a
+		a, b = b, a+b
+		return result
+	}
+}
+
+func idGenerator(prefix string) func() string {
+	id := 0
+	return func() string {
+		id++
+		return fmt.Sprintf("%s-%03d", prefix, id)
+	}
+}
+
+// state is one turnstile state: its name and the closure that handles
+// an event in that state and picks the next one.
+type state struct {
+	name string
+	on   func(event string) (next *state, action string)
+}
+
+type turnstile struct {
+	current *state
+	coins   func() int
+}
+
+func newTurnstile() *turnstile {
+	coins := 0
+	locked := &state{name: "locked"}
+	unlocked := &state{name: "unlocked"}
+	locked.on = func(event string) (*state, string) {
+		switch event {
+		case "coin":
+			coins++
+			return unlocked, "accept coin, unlock"
+		case "push":
+			return locked, "blocked - insert a coin"
+		}
+		return locked, "ignored"
+	}
+	unlocked.on = func(event string) (*state, string) {
+		switch event {
+		case "coin":
+			coins++
+			return unlocked, "already open, keep coin"
+		case "push":
+			return locked, "let one through, lock"
+		}
+		return unlocked, "ignored"
+	}
+
+	return &turnstile{current: locked, coins: func() int { return coins }}
+}
+
+func (t *turnstile) state() string {
+	return t.current.name
+}
+
+func (t *turnstile) handle(event string) (action string) {
+	t.current, action = t.current.on(event)
+	return action
+}
+
+const loopVarSource = `package main
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+func main() {
+	var funcs []func() int
+	for i := 0; i < 3; i++ {
+		funcs = append(funcs, func() int { return i })
+	}
+	for _, f := range funcs {
+		fmt.Print(f(), " ")
+	}
+	fmt.Println("← closures from a 3-clause for loop")
+
+	var mu sync.Mutex
+	var seen []string
+	var wg sync.WaitGroup
+	for _, name := range []string{"ann", "ben", "cy"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			mu.Lock()
+			seen = append(seen, name)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	sort.Strings(seen)
+	fmt.Println(seen, "← goroutines from a range loop")
+}
+`
+
+func runLoopVarComparison() {
+	for _, version := range []string{"1.21", "1.22"} {
+		dir, err := writeScratchModule("loopvar-"+version+"-", map[string]string{
+			"go.mod":  "module loopvar\n\ngo " + version + "\n",
+			"main.go": loopVarSource,
+		})
+		if err != nil {
+			fmt.Printf("   ❌ could not create scratch module: %v\n\n", err)
+			return
+		}
+
+		fmt.Printf("   go.mod: go %s\n", version)
+		fmt.Printf("   $ go run .\n")
+		output, err := runGoTool(dir, "run", ".")
+		printToolOutput(output)
+		if err != nil {
+			fmt.Printf("   ❌ %v\n", err)
+		}
+		if version == "1.21" {
+			fmt.Printf("   $ go vet .\n")
+			output, _ = runGoTool(dir, "vet", ".")
+			printToolOutput(output)
+		}
+		fmt.Println()
+		os.RemoveAll(dir)
+	}
+	fmt.Printf("   💡 Under go 1.21 the whole loop shares ONE i and ONE name, so every\n")
+	fmt.Printf("      closure sees the final value (3, and usually \"cy\" - it is a race)\n")
+	fmt.Printf("   💡 The module's go line, not the toolchain version, picks the rule,\n")
+	fmt.Printf("      so old code keeps its old meaning until you bump go.mod\n\n")
+}
+
+// Print helper functions
+
+func printClosureHeader(title string) {
+	fmt.Println("\n" + strings.Repeat("=", 60))
+	fmt.Printf("  %s\n", title)
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
+
+func printClosureSection(title string) {
+	fmt.Printf("┌─ %s\n", title)
+	fmt.Println("│")
+}
+
+func printClosureFooter() {
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println("  ✅ Tutorial Complete!")
+	fmt.Println("  💡 Key Takeaways:")
+	fmt.Println("     • Closures capture variables, so they see later changes")
+	fmt.Println("     • Each call to a closure factory gets fresh variables")
+	fmt.Println("     • Since Go 1.22 every loop iteration has its own variable")
+	fmt.Println("     • The go line in go.mod decides which loop rule applies")
+	fmt.Println("     • Closures make compact generators and state machines")
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
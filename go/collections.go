@@ -0,0 +1,330 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	stdmaps "maps"
+	stdslices "slices"
+	"strings"
+)
+
+func collectionPackages() {
+	printCollectionHeader("GO SLICES AND MAPS PACKAGES TUTORIAL")
+
+	// Section 1: Why the slices and maps Packages?
+	printCollectionSection("1. Why the slices and maps Packages?")
+	fmt.Printf("   The Slices and Maps tutorials use built-ins and hand-written loops.\n")
+	fmt.Printf("   Since Go 1.21 the standard library ships generic helpers instead:\n")
+	fmt.Printf("   ✅ Less code to write and review\n")
+	fmt.Printf("   ✅ Fewer off-by-one and aliasing bugs\n")
+	fmt.Printf("   ✅ Work with any element type\n\n")
+	fmt.Printf("   Every section runs the hand-rolled version first, then the package call.\n\n")
+
+	// Section 2: slices.Sort and slices.SortFunc
+	printCollectionSection("2. slices.Sort and slices.SortFunc")
+	unsorted := []int{42, 7, 19, 3, 25}
+	fmt.Printf("   nums := %v\n\n", unsorted)
+	handSorted := append([]int(nil), unsorted...)
+	insertionSort(handSorted)
+	libSorted := append([]int(nil), unsorted...)
+	stdslices.Sort(libSorted)
+	printHandVsLib(
+		[]string{"for i := 1; i < len(nums); i++ {", "    for j := i; j > 0 && nums[j] < nums[j-1]; j-- {", "        nums[j], nums[j-1] = nums[j-1], nums[j]", "    }", "}"},
+		handSorted,
+		"slices.Sort(nums)",
+		libSorted,
+	)
+
+	people := []Person{
+		{name: "Alice", age: 30, city: "NYC"},
+		{name: "Bob", age: 25, city: "LA"},
+		{name: "Charlie", age: 35, city: "Chicago"},
+	}
+	stdslices.SortFunc(people, func(a, b Person) int {
+		return cmp.Compare(a.age, b.age)
+	})
+	fmt.Printf("   Sorting structs by a field:\n")
+	fmt.Printf("   slices.SortFunc(people, func(a, b Person) int {\n")
+	fmt.Printf("       return cmp.Compare(a.age, b.age)\n")
+	fmt.Printf("   })\n")
+	fmt.Printf("   → %+v\n\n", people)
+
+	// Section 3: slices.BinarySearch
+	printCollectionSection("3. slices.BinarySearch (Sorted Slices Only)")
+	fmt.Printf("   sorted := %v\n\n", libSorted)
+	handIndex, handFound := manualBinarySearch(libSorted, 19)
+	libIndex, libFound := stdslices.BinarySearch(libSorted, 19)
+	printHandVsLib(
+		[]string{"lo, hi := 0, len(sorted)", "for lo < hi {", "    mid := (lo + hi) / 2", "    if sorted[mid] < 19 { lo = mid + 1 } else { hi = mid }", "}", "found := lo < len(sorted) && sorted[lo] == 19"},
+		fmt.Sprintf("index=%d found=%t", handIndex, handFound),
+		"i, found := slices.BinarySearch(sorted, 19)",
+		fmt.Sprintf("index=%d found=%t", libIndex, libFound),
+	)
+	missIndex, missFound := stdslices.BinarySearch(libSorted, 20)
+	fmt.Printf("   slices.BinarySearch(sorted, 20) → index=%d found=%t\n", missIndex, missFound)
+	fmt.Printf("   💡 When not found, the index is where 20 WOULD be inserted\n\n")
+
+	// Section 4: slices.Index and slices.Contains
+	printCollectionSection("4. slices.Index and slices.Contains")
+	fruits := []string{"Apple", "Banana", "Cherry", "Date", "Elderberry"}
+	fmt.Printf("   fruits := %q\n\n", fruits)
+	handPos := -1
+	for i, fruit := range fruits {
+		if fruit == "Cherry" {
+			handPos = i
+			break
+		}
+	}
+	printHandVsLib(
+		[]string{"pos := -1", "for i, fruit := range fruits {", "    if fruit == \"Cherry\" { pos = i; break }", "}"},
+		handPos,
+		"pos := slices.Index(fruits, \"Cherry\")",
+		stdslices.Index(fruits, "Cherry"),
+	)
+	handHas := false
+	for _, fruit := range fruits {
+		if fruit == "Fig" {
+			handHas = true
+			break
+		}
+	}
+	printHandVsLib(
+		[]string{"has := false", "for _, fruit := range fruits {", "    if fruit == \"Fig\" { has = true; break }", "}"},
+		handHas,
+		"has := slices.Contains(fruits, \"Fig\")",
+		stdslices.Contains(fruits, "Fig"),
+	)
+	fmt.Printf("   slices.IndexFunc(fruits, long) → %d (first name longer than 5)\n\n",
+		stdslices.IndexFunc(fruits, func(s string) bool { return len(s) > 5 }))
+
+	// Section 5: slices.Insert and slices.Delete
+	printCollectionSection("5. slices.Insert and slices.Delete")
+	base := []int{1, 2, 4, 5}
+	fmt.Printf("   nums := %v\n\n", base)
+	handInserted := append([]int(nil), base...)
+	handInserted = append(handInserted[:2], append([]int{3}, handInserted[2:]...)...)
+	printHandVsLib(
+		[]string{"nums = append(nums[:2], append([]int{3}, nums[2:]...)...)"},
+		handInserted,
+		"nums = slices.Insert(nums, 2, 3)",
+		stdslices.Insert(append([]int(nil), base...), 2, 3),
+	)
+	handDeleted := append([]int(nil), base...)
+	handDeleted = append(handDeleted[:1], handDeleted[3:]...)
+	printHandVsLib(
+		[]string{"nums = append(nums[:1], nums[3:]...)   // drop indexes 1 and 2"},
+		handDeleted,
+		"nums = slices.Delete(nums, 1, 3)",
+		stdslices.Delete(append([]int(nil), base...), 1, 3),
+	)
+	fmt.Printf("   💡 slices.Delete also zeroes the freed tail so the GC can reclaim it\n\n")
+
+	// Section 6: slices.Compact
+	printCollectionSection("6. slices.Compact (Remove Consecutive Duplicates)")
+	dupes := []int{1, 1, 2, 3, 3, 3, 4, 1}
+	fmt.Printf("   nums := %v\n\n", dupes)
+	var handCompact []int
+	for i, n := range dupes {
+		if i == 0 || n != dupes[i-1] {
+			handCompact = append(handCompact, n)
+		}
+	}
+	printHandVsLib(
+		[]string{"var out []int", "for i, n := range nums {", "    if i == 0 || n != nums[i-1] { out = append(out, n) }", "}"},
+		handCompact,
+		"nums = slices.Compact(nums)",
+		stdslices.Compact(append([]int(nil), dupes...)),
+	)
+	fmt.Printf("   💡 Only NEIGHBOURS are merged - sort first to remove every duplicate:\n")
+	deduped := append([]int(nil), dupes...)
+	stdslices.Sort(deduped)
+	fmt.Printf("   slices.Compact(sorted) → %v\n\n", stdslices.Compact(deduped))
+
+	// Section 7: slices.Clone
+	printCollectionSection("7. slices.Clone (Slices Tutorial, Section 13)")
+	largeSlice := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	neededPart := largeSlice[2:5]
+	fmt.Printf("   neededPart := largeSlice[2:5]   // %v (len=%d, cap=%d)\n\n", neededPart, len(neededPart), cap(neededPart))
+	independentCopy := make([]int, 3)
+	copy(independentCopy, neededPart)
+	cloned := stdslices.Clone(neededPart)
+	printHandVsLib(
+		[]string{"independentCopy := make([]int, 3)", "copy(independentCopy, neededPart)"},
+		fmt.Sprintf("%v (len=%d, cap=%d)", independentCopy, len(independentCopy), cap(independentCopy)),
+		"cloned := slices.Clone(neededPart)",
+		fmt.Sprintf("%v (len=%d, cap=%d)", cloned, len(cloned), cap(cloned)),
+	)
+	cloned[0] = 999
+	fmt.Printf("   cloned[0] = 999 → largeSlice still %v ✅\n\n", largeSlice)
+
+	// Section 8: slices.Equal
+	printCollectionSection("8. slices.Equal")
+	left := []string{"a", "b", "c"}
+	right := []string{"a", "b", "c"}
+	fmt.Printf("   left, right := %q, %q\n", left, right)
+	fmt.Printf("   ⚠️  left == right does not compile - slices are not comparable\n\n")
+	handEqual := len(left) == len(right)
+	for i := 0; handEqual && i < len(left); i++ {
+		handEqual = left[i] == right[i]
+	}
+	printHandVsLib(
+		[]string{"equal := len(left) == len(right)", "for i := 0; equal && i < len(left); i++ {", "    equal = left[i] == right[i]", "}"},
+		handEqual,
+		"equal := slices.Equal(left, right)",
+		stdslices.Equal(left, right),
+	)
+
+	// Section 9: Collecting Map Keys
+	printCollectionSection("9. Collecting Map Keys (Maps Tutorial, Section 13)")
+	scores := map[string]int{"Math": 95, "English": 88, "Art": 72}
+	fmt.Printf("   scores := %v\n\n", scores)
+	var handKeys []string
+	for key := range scores {
+		handKeys = append(handKeys, key)
+	}
+	insertionSort(handKeys)
+	printHandVsLib(
+		[]string{"var keys []string", "for key := range scores {", "    keys = append(keys, key)", "}", "// ...then sort keys by hand"},
+		handKeys,
+		"keys := slices.Sorted(maps.Keys(scores))",
+		stdslices.Sorted(stdmaps.Keys(scores)),
+	)
+
+	// Section 10: maps.Clone
+	printCollectionSection("10. maps.Clone (Maps Tutorial, Section 16)")
+	original := map[string]int{"a": 1, "b": 2}
+	fmt.Printf("   original := %v\n", original)
+	fmt.Printf("   copy := original   // the Maps tutorial shows this shares data!\n\n")
+	handClone := make(map[string]int, len(original))
+	for k, v := range original {
+		handClone[k] = v
+	}
+	libClone := stdmaps.Clone(original)
+	printHandVsLib(
+		[]string{"clone := make(map[string]int, len(original))", "for k, v := range original {", "    clone[k] = v", "}"},
+		handClone,
+		"clone := maps.Clone(original)",
+		libClone,
+	)
+	libClone["a"] = 100
+	fmt.Printf("   clone[\"a\"] = 100 → original still %v ✅\n\n", original)
+
+	// Section 11: maps.Copy
+	printCollectionSection("11. maps.Copy (Merge One Map Into Another)")
+	defaults := map[string]string{"theme": "light", "lang": "en"}
+	overrides := map[string]string{"theme": "dark", "font": "mono"}
+	fmt.Printf("   defaults  := %v\n", defaults)
+	fmt.Printf("   overrides := %v\n", overrides)
+	fmt.Printf("   settings := maps.Clone(defaults)\n\n")
+	handMerged := stdmaps.Clone(defaults)
+	for k, v := range overrides {
+		handMerged[k] = v
+	}
+	libMerged := stdmaps.Clone(defaults)
+	stdmaps.Copy(libMerged, overrides)
+	printHandVsLib(
+		[]string{"for k, v := range overrides {", "    settings[k] = v", "}"},
+		handMerged,
+		"maps.Copy(settings, overrides)",
+		libMerged,
+	)
+
+	// Section 12: maps.DeleteFunc
+	printCollectionSection("12. maps.DeleteFunc")
+	grades := map[string]int{"Alice": 95, "Bob": 58, "Carol": 82, "Dave": 41}
+	fmt.Printf("   grades := %v\n\n", grades)
+	handPassing := stdmaps.Clone(grades)
+	for name, grade := range handPassing {
+		if grade < 60 {
+			delete(handPassing, name)
+		}
+	}
+	libPassing := stdmaps.Clone(grades)
+	stdmaps.DeleteFunc(libPassing, func(name string, grade int) bool {
+		return grade < 60
+	})
+	printHandVsLib(
+		[]string{"for name, grade := range grades {", "    if grade < 60 { delete(grades, name) }", "}"},
+		handPassing,
+		"maps.DeleteFunc(grades, func(name string, grade int) bool { return grade < 60 })",
+		libPassing,
+	)
+	fmt.Printf("   💡 Deleting while ranging over a map is safe in Go\n")
+	fmt.Printf("   maps.Equal(hand, lib) → %t\n\n", stdmaps.Equal(handPassing, libPassing))
+
+	// Section 13: Summary
+	printCollectionSection("13. Summary")
+	fmt.Printf("   ┌──────────────────────┬──────────────────────────────────┐\n")
+	fmt.Printf("   │ Instead of...        │ Use                              │\n")
+	fmt.Printf("   ├──────────────────────┼──────────────────────────────────┤\n")
+	fmt.Printf("   │ hand-written sort    │ slices.Sort / slices.SortFunc    │\n")
+	fmt.Printf("   │ search loop          │ slices.Index / Contains / Binary │\n")
+	fmt.Printf("   │ append gymnastics    │ slices.Insert / slices.Delete    │\n")
+	fmt.Printf("   │ make + copy          │ slices.Clone                     │\n")
+	fmt.Printf("   │ element-by-element   │ slices.Equal / maps.Equal        │\n")
+	fmt.Printf("   │ key-collecting loop  │ slices.Sorted(maps.Keys(m))      │\n")
+	fmt.Printf("   │ map copy loop        │ maps.Clone / maps.Copy           │\n")
+	fmt.Printf("   │ delete-in-range loop │ maps.DeleteFunc                  │\n")
+	fmt.Printf("   └──────────────────────┴──────────────────────────────────┘\n\n")
+
+	printCollectionFooter()
+}
+
+// Helper functions for demonstrations
+
+func insertionSort[T cmp.Ordered](items []T) {
+	for i := 1; i < len(items); i++ {
+		for j := i; j > 0 && items[j] < items[j-1]; j-- {
+			items[j], items[j-1] = items[j-1], items[j]
+		}
+	}
+}
+
+func manualBinarySearch(sorted []int, target int) (int, bool) {
+	lo, hi := 0, len(sorted)
+	for lo < hi {
+		mid := (lo + hi) / 2
+		if sorted[mid] < target {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo, lo < len(sorted) && sorted[lo] == target
+}
+
+func printHandVsLib(handCode []string, handResult any, libCode string, libResult any) {
+	fmt.Printf("   ✋ Hand-rolled:\n")
+	for _, line := range handCode {
+		fmt.Printf("      %s\n", line)
+	}
+	fmt.Printf("      → %v\n", handResult)
+	fmt.Printf("   📦 Standard library:\n")
+	fmt.Printf("      %s\n", libCode)
+	fmt.Printf("      → %v\n\n", libResult)
+}
+
+// Print helper functions
+
+func printCollectionHeader(title string) {
+	fmt.Println("\n" + strings.Repeat("=", 60))
+	fmt.Printf("  %s\n", title)
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
+
+func printCollectionSection(title string) {
+	fmt.Printf("┌─ %s\n", title)
+	fmt.Println("│")
+}
+
+func printCollectionFooter() {
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println("  ✅ Tutorial Complete!")
+	fmt.Println("  💡 Key Takeaways:")
+	fmt.Println("     • The slices and maps packages replace common hand loops")
+	fmt.Println("     • Sort before BinarySearch or a full-dedup Compact")
+	fmt.Println("     • slices.Clone and maps.Clone give independent copies")
+	fmt.Println("     • slices.Sorted(maps.Keys(m)) gives a stable key order")
+	fmt.Println("     • Prefer the package call - it documents your intent")
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
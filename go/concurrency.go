@@ -0,0 +1,600 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+func concurrencyPatterns() {
+	printConcHeader("GO CONCURRENCY PATTERNS TUTORIAL")
+	fmt.Printf("   Each pattern runs for real. Timelines show one row per goroutine;\n")
+	fmt.Printf("   each character is a slice of wall-clock time, and the symbol is\n")
+	fmt.Printf("   the job that goroutine was busy with (· = idle).\n\n")
+
+	// Section 1: Worker Pool
+	printConcSection("1. Bounded Worker Pool")
+	fmt.Printf("   jobs := make(chan int)\n")
+	fmt.Printf("   for w := 1; w <= 3; w++ {\n")
+	fmt.Printf("       wg.Go(func() {\n")
+	fmt.Printf("           for job := range jobs {   // each worker pulls the next job\n")
+	fmt.Printf("               results <- process(job)\n")
+	fmt.Printf("           }\n")
+	fmt.Printf("       })\n")
+	fmt.Printf("   }\n\n")
+	tl := newTimeline()
+	results := workerPool(tl, 3, []int{1, 2, 3, 4, 5, 6, 7, 8, 9})
+	printConcTimeline(tl)
+	fmt.Printf("   Results (arrival order): %v\n", results)
+	fmt.Printf("   💡 3 workers bound the concurrency no matter how many jobs arrive;\n")
+	fmt.Printf("      a free worker immediately takes the next job\n\n")
+
+	// Section 2: Pipeline with Cancellation
+	printConcSection("2. Pipeline with Cancellation")
+	fmt.Printf("   generate → square → format, each stage a goroutine joined by channels.\n")
+	fmt.Printf("   The consumer only wants 4 results, then calls cancel():\n\n")
+	fmt.Printf("   select {\n")
+	fmt.Printf("   case out <- v:\n")
+	fmt.Printf("   case <-ctx.Done():   // every send also watches for cancellation\n")
+	fmt.Printf("       return\n")
+	fmt.Printf("   }\n\n")
+	tl = newTimeline()
+	consumed := runPipeline(tl, 4)
+	printConcTimeline(tl)
+	printConcNotes(tl)
+	fmt.Printf("   Consumer received: %v\n", consumed)
+	fmt.Printf("   💡 Without ctx, the stages would block forever on their next send\n")
+	fmt.Printf("      - a goroutine leak\n\n")
+
+	// Section 3: Fan-Out / Fan-In
+	printConcSection("3. Fan-Out / Fan-In")
+	fmt.Printf("   Fan-out: start several workers, each with its OWN output channel.\n")
+	fmt.Printf("   Fan-in:  merge(ctx, chans...) copies them all into one channel.\n\n")
+	tl = newTimeline()
+	merged := fanOutFanIn(tl, []string{"a", "b", "c", "d", "e", "f"}, 3)
+	printConcTimeline(tl)
+	fmt.Printf("   Merged stream: %s\n", strings.Join(merged, " "))
+	fmt.Printf("   💡 merge closes its output only after every input is drained,\n")
+	fmt.Printf("      using a WaitGroup - the consumer just ranges over one channel\n\n")
+
+	// Section 4: Semaphore
+	printConcSection("4. Semaphore with a Buffered Channel")
+	fmt.Printf("   sem := make(chan struct{}, 2)\n")
+	fmt.Printf("   sem <- struct{}{}          // acquire (blocks when 2 are held)\n")
+	fmt.Printf("   defer func() { <-sem }()   // release\n\n")
+	tl = newTimeline()
+	peak := semaphoreDemo(tl, 6, 2)
+	printConcTimeline(tl)
+	fmt.Printf("   6 goroutines started at once; peak concurrency observed = %d\n", peak)
+	fmt.Printf("   💡 Unlike a worker pool, every task gets its own goroutine - the\n")
+	fmt.Printf("      semaphore only limits how many run the guarded part at a time\n\n")
+
+	// Section 5: errgroup-Style Error Propagation
+	printConcSection("5. errgroup-Style Error Propagation (stdlib only)")
+	fmt.Printf("   type group struct {\n")
+	fmt.Printf("       wg     sync.WaitGroup\n")
+	fmt.Printf("       cancel context.CancelFunc\n")
+	fmt.Printf("       once   sync.Once\n")
+	fmt.Printf("       err    error   // first error wins, then cancel the rest\n")
+	fmt.Printf("   }\n\n")
+	tl = newTimeline()
+	err := errGroupDemo(tl)
+	printConcTimeline(tl)
+	printConcNotes(tl)
+	fmt.Printf("   g.Wait() = %v\n", err)
+	fmt.Printf("   💡 The first failure cancels the shared ctx; the others notice and\n")
+	fmt.Printf("      stop early instead of finishing work nobody will use\n\n")
+
+	// Section 6: Token Bucket
+	printConcSection("6. Token-Bucket Rate Limiter")
+	fmt.Printf("   Bucket holds 3 tokens (the burst) and refills 1 every 40ms.\n")
+	fmt.Printf("   8 requests arrive at once; each must take a token first\n")
+	fmt.Printf("   (- = waiting for a token, digit = admitted):\n\n")
+	tl = newTimeline()
+	admitted := tokenBucketDemo(tl, 8, 3, 40*time.Millisecond)
+	printConcTimeline(tl)
+	fmt.Printf("   ┌─────────┬──────────────┐\n")
+	fmt.Printf("   │ Request │ Admitted at  │\n")
+	fmt.Printf("   ├─────────┼──────────────┤\n")
+	for i, at := range admitted {
+		fmt.Printf("   │ %-7d │ %9v    │\n", i+1, at.Round(time.Millisecond))
+	}
+	fmt.Printf("   └─────────┴──────────────┘\n")
+	fmt.Printf("   💡 The first 3 pass immediately (burst), then one per refill\n\n")
+
+	// Section 7: Choosing a Pattern
+	printConcSection("7. Choosing a Pattern")
+	fmt.Printf("   ┌───────────────────┬──────────────────────────────────────────┐\n")
+	fmt.Printf("   │ Pattern           │ Use it when                              │\n")
+	fmt.Printf("   ├───────────────────┼──────────────────────────────────────────┤\n")
+	fmt.Printf("   │ Worker pool       │ many jobs, fixed number of workers       │\n")
+	fmt.Printf("   │ Pipeline          │ data flows through distinct stages       │\n")
+	fmt.Printf("   │ Fan-out / fan-in  │ parallelize one stage, merge the results │\n")
+	fmt.Printf("   │ Semaphore         │ cap access to a scarce resource          │\n")
+	fmt.Printf("   │ errgroup          │ all-or-nothing tasks, fail fast          │\n")
+	fmt.Printf("   │ Token bucket      │ limit rate over time, allow short bursts │\n")
+	fmt.Printf("   └───────────────────┴──────────────────────────────────────────┘\n\n")
+
+	printConcFooter()
+}
+
+// Patterns
+
+func workerPool(tl *timeline, workers int, jobs []int) []int {
+	jobCh := make(chan int)
+	resultCh := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 1; w <= workers; w++ {
+		lane := fmt.Sprintf("worker %d", w)
+		tl.addLane(lane)
+		wg.Go(func() {
+			for job := range jobCh {
+				start := time.Now()
+				time.Sleep(time.Duration(10+(job*7)%30) * time.Millisecond)
+				tl.span(lane, job, start)
+				resultCh <- job * job
+			}
+		})
+	}
+
+	go func() {
+		for _, job := range jobs {
+			jobCh <- job
+		}
+		close(jobCh)
+	}()
+	go func() {
+		wg.Wait()
+		close(resultCh)
+	}()
+
+	var results []int
+	for result := range resultCh {
+		results = append(results, result)
+	}
+	return results
+}
+
+func runPipeline(tl *timeline, want int) []string {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for _, lane := range []string{"generate", "square", "format"} {
+		tl.addLane(lane)
+	}
+
+	numbers := make(chan int)
+	wg.Go(func() {
+		defer close(numbers)
+		for n := 1; ; n++ {
+			start := time.Now()
+			time.Sleep(8 * time.Millisecond)
+			tl.span("generate", n, start)
+			select {
+			case numbers <- n:
+			case <-ctx.Done():
+				tl.note("generate", "stopped before sending %d: %v", n, ctx.Err())
+				return
+			}
+		}
+	})
+
+	squares := make(chan int)
+	wg.Go(func() {
+		defer close(squares)
+		for n := range numbers {
+			start := time.Now()
+			time.Sleep(15 * time.Millisecond)
+			tl.span("square", n, start)
+			select {
+			case squares <- n * n:
+			case <-ctx.Done():
+				tl.note("square", "dropped %d: %v", n*n, ctx.Err())
+				return
+			}
+		}
+	})
+
+	formatted := make(chan string)
+	wg.Go(func() {
+		defer close(formatted)
+		for sq := range squares {
+			start := time.Now()
+			time.Sleep(5 * time.Millisecond)
+			tl.span("format", isqrt(sq), start)
+			select {
+			case formatted <- fmt.Sprintf("#%d", sq):
+			case <-ctx.Done():
+				tl.note("format", "dropped #%d: %v", sq, ctx.Err())
+				return
+			}
+		}
+	})
+
+	var got []string
+	for s := range formatted {
+		got = append(got, s)
+		if len(got) == want {
+			tl.note("consumer", "has %d results, calling cancel()", want)
+			cancel()
+			break
+		}
+	}
+	wg.Wait()
+	tl.note("consumer", "wg.Wait() returned - all stages exited")
+	return got
+}
+
+func fanOutFanIn(tl *timeline, items []string, workers int) []string {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	source := make(chan string)
+	go func() {
+		defer close(source)
+		for _, item := range items {
+			select {
+			case source <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var outputs []<-chan string
+	for w := 1; w <= workers; w++ {
+		lane := fmt.Sprintf("worker %d", w)
+		tl.addLane(lane)
+		out := make(chan string)
+		outputs = append(outputs, out)
+		go func() {
+			defer close(out)
+			for item := range source {
+				start := time.Now()
+				time.Sleep(time.Duration(15+w*10) * time.Millisecond)
+				tl.spanMark(lane, item, start)
+				select {
+				case out <- fmt.Sprintf("%s←w%d", strings.ToUpper(item), w):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	var merged []string
+	for value := range merge(ctx, outputs...) {
+		merged = append(merged, value)
+	}
+	return merged
+}
+
+func merge(ctx context.Context, inputs ...<-chan string) <-chan string {
+	out := make(chan string)
+	var wg sync.WaitGroup
+	for _, in := range inputs {
+		wg.Go(func() {
+			for value := range in {
+				select {
+				case out <- value:
+				case <-ctx.Done():
+					return
+				}
+			}
+		})
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+func semaphoreDemo(tl *timeline, tasks, limit int) int {
+	sem := make(chan struct{}, limit)
+	var running, peak atomic.Int32
+	var wg sync.WaitGroup
+	for task := 1; task <= tasks; task++ {
+		lane := fmt.Sprintf("task %d", task)
+		tl.addLane(lane)
+		wg.Go(func() {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			now := running.Add(1)
+			for {
+				old := peak.Load()
+				if now <= old || peak.CompareAndSwap(old, now) {
+					break
+				}
+			}
+			start := time.Now()
+			time.Sleep(time.Duration(20+task*5) * time.Millisecond)
+			tl.span(lane, task, start)
+			running.Add(-1)
+		})
+	}
+	wg.Wait()
+	return int(peak.Load())
+}
+
+type group struct {
+	wg     sync.WaitGroup
+	cancel context.CancelFunc
+	once   sync.Once
+	err    error
+}
+
+func withContextGroup(ctx context.Context) (*group, context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	return &group{cancel: cancel}, ctx
+}
+
+func (g *group) Go(fn func() error) {
+	g.wg.Go(func() {
+		if err := fn(); err != nil {
+			g.once.Do(func() {
+				g.err = err
+				g.cancel()
+			})
+		}
+	})
+}
+
+func (g *group) Wait() error {
+	g.wg.Wait()
+	g.cancel()
+	return g.err
+}
+
+func errGroupDemo(tl *timeline) error {
+	g, ctx := withContextGroup(context.Background())
+	tasks := []struct {
+		name     string
+		duration time.Duration
+		fail     bool
+	}{
+		{"fetch users", 90 * time.Millisecond, false},
+		{"fetch orders", 35 * time.Millisecond, true},
+		{"fetch prices", 120 * time.Millisecond, false},
+		{"fetch stock", 20 * time.Millisecond, false},
+	}
+	for i, task := range tasks {
+		tl.addLane(task.name)
+		g.Go(func() error {
+			start := time.Now()
+			select {
+			case <-time.After(task.duration):
+				tl.span(task.name, i+1, start)
+				if task.fail {
+					tl.note(task.name, "failed")
+					return fmt.Errorf("%s: %w", task.name, errUpstreamDown)
+				}
+				tl.note(task.name, "done")
+				return nil
+			case <-ctx.Done():
+				tl.span(task.name, i+1, start)
+				tl.note(task.name, "stopped early: %v", ctx.Err())
+				return ctx.Err()
+			}
+		})
+	}
+	return g.Wait()
+}
+
+var errUpstreamDown = errors.New("upstream returned 503")
+
+type tokenBucket struct {
+	tokens chan struct{}
+	stop   chan struct{}
+}
+
+func newTokenBucket(capacity int, every time.Duration) *tokenBucket {
+	b := &tokenBucket{tokens: make(chan struct{}, capacity), stop: make(chan struct{})}
+	for range capacity {
+		b.tokens <- struct{}{}
+	}
+	go func() {
+		ticker := time.NewTicker(every)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				select {
+				case b.tokens <- struct{}{}:
+				default: // bucket full, the token is discarded
+				}
+			case <-b.stop:
+				return
+			}
+		}
+	}()
+	return b
+}
+
+func (b *tokenBucket) Wait() {
+	<-b.tokens
+}
+
+func (b *tokenBucket) Stop() {
+	close(b.stop)
+}
+
+func tokenBucketDemo(tl *timeline, requests, capacity int, every time.Duration) []time.Duration {
+	bucket := newTokenBucket(capacity, every)
+	defer bucket.Stop()
+
+	admitted := make([]time.Duration, requests)
+	var wg sync.WaitGroup
+	for r := 1; r <= requests; r++ {
+		lane := fmt.Sprintf("request %d", r)
+		tl.addLane(lane)
+		wg.Go(func() {
+			arrived := time.Now()
+			bucket.Wait()
+			admitted[r-1] = tl.elapsed()
+			tl.spanMark(lane, "-", arrived)
+			tl.point(lane, r)
+		})
+		time.Sleep(time.Millisecond) // keep arrival order stable for the table
+	}
+	wg.Wait()
+	return admitted
+}
+
+func isqrt(n int) int {
+	r := 0
+	for (r+1)*(r+1) <= n {
+		r++
+	}
+	return r
+}
+
+// Timeline recording
+
+type timeline struct {
+	mu    sync.Mutex
+	start time.Time
+	lanes []string
+	spans []timelineSpan
+	notes []timelineNote
+}
+
+type timelineSpan struct {
+	lane     string
+	mark     string
+	from, to time.Duration
+}
+
+type timelineNote struct {
+	at   time.Duration
+	lane string
+	text string
+}
+
+func newTimeline() *timeline {
+	return &timeline{start: time.Now()}
+}
+
+func (t *timeline) elapsed() time.Duration {
+	return time.Since(t.start)
+}
+
+func (t *timeline) addLane(lane string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.lanes = append(t.lanes, lane)
+}
+
+func (t *timeline) span(lane string, job int, from time.Time) {
+	t.spanMark(lane, jobMark(job), from)
+}
+
+func (t *timeline) spanMark(lane, mark string, from time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.spans = append(t.spans, timelineSpan{lane, mark, from.Sub(t.start), time.Since(t.start)})
+}
+
+func (t *timeline) point(lane string, job int) {
+	now := time.Since(t.start)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.spans = append(t.spans, timelineSpan{lane, jobMark(job), now, now})
+}
+
+func (t *timeline) note(lane, format string, args ...any) {
+	now := time.Since(t.start)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.notes = append(t.notes, timelineNote{now, lane, fmt.Sprintf(format, args...)})
+}
+
+func jobMark(job int) string {
+	const marks = "123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	return string(marks[(job-1)%len(marks)])
+}
+
+// Print helper functions
+
+func printConcTimeline(t *timeline) {
+	const width = 50
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var total time.Duration
+	for _, s := range t.spans {
+		total = max(total, s.to)
+	}
+	if total == 0 {
+		return
+	}
+	column := func(d time.Duration) int {
+		return min(int(int64(d)*width/int64(total)), width-1)
+	}
+
+	labelWidth := 0
+	for _, lane := range t.lanes {
+		labelWidth = max(labelWidth, len(lane))
+	}
+	for _, lane := range t.lanes {
+		row := []rune(strings.Repeat("·", width))
+		for _, s := range t.spans {
+			if s.lane != lane {
+				continue
+			}
+			from, to := column(s.from), column(s.to)
+			if to > from && s.to > s.from {
+				to-- // the span ends at the start of its last column
+			}
+			for c := from; c <= to; c++ {
+				row[c] = rune(s.mark[0])
+			}
+		}
+		fmt.Printf("   %-*s │%s│\n", labelWidth, lane, string(row))
+	}
+	scale := fmt.Sprintf("%v", total.Round(time.Millisecond))
+	fmt.Printf("   %-*s  0ms%s%s\n\n", labelWidth, "", strings.Repeat(" ", width-3-len(scale)+1), scale)
+}
+
+func printConcNotes(t *timeline) {
+	t.mu.Lock()
+	notes := append([]timelineNote(nil), t.notes...)
+	t.mu.Unlock()
+
+	sort.SliceStable(notes, func(i, j int) bool { return notes[i].at < notes[j].at })
+	for _, n := range notes {
+		fmt.Printf("   %6v  %-13s %s\n", n.at.Round(time.Millisecond), n.lane, n.text)
+	}
+	fmt.Println()
+}
+
+func printConcHeader(title string) {
+	fmt.Println("\n" + strings.Repeat("=", 60))
+	fmt.Printf("  %s\n", title)
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
+
+func printConcSection(title string) {
+	fmt.Printf("┌─ %s\n", title)
+	fmt.Println("│")
+}
+
+func printConcFooter() {
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println("  ✅ Tutorial Complete!")
+	fmt.Println("  💡 Key Takeaways:")
+	fmt.Println("     • Bound concurrency with a fixed pool or a semaphore")
+	fmt.Println("     • Every blocking send should also watch ctx.Done()")
+	fmt.Println("     • Fan-in with a WaitGroup that closes the merged channel")
+	fmt.Println("     • Cancel the group on the first error")
+	fmt.Println("     • A token bucket allows bursts but caps the average rate")
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
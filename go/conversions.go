@@ -0,0 +1,419 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"math"
+	"math/big"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+func conversions() {
+	printConvHeader("GO NUMERIC CONVERSIONS TUTORIAL")
+
+	// Section 1: The Rules
+	printConvSection("1. What a Conversion Does")
+	fmt.Printf("   Go never converts numbers implicitly, and T(x) never fails at\n")
+	fmt.Printf("   runtime - but it does not always keep the value:\n\n")
+	fmt.Printf("   ┌────────────────────┬──────────────────────────────────────────┐\n")
+	fmt.Printf("   │ Conversion         │ What happens when it does not fit        │\n")
+	fmt.Printf("   ├────────────────────┼──────────────────────────────────────────┤\n")
+	fmt.Printf("   │ integer → integer  │ wraparound: keep the low bits            │\n")
+	fmt.Printf("   │ float → integer    │ truncation toward zero, if in range      │\n")
+	fmt.Printf("   │ float → integer    │ implementation-specific, if out of range │\n")
+	fmt.Printf("   │ integer → float    │ rounding to the nearest float            │\n")
+	fmt.Printf("   │ float64 → float32  │ rounding; out of range is impl-specific  │\n")
+	fmt.Printf("   │ constant → any     │ compile error unless representable       │\n")
+	fmt.Printf("   └────────────────────┴──────────────────────────────────────────┘\n")
+	fmt.Printf("   💡 The Data Types tutorial's int → float64 example is the easy\n")
+	fmt.Printf("      case: every int up to 2^53 fits exactly in a float64\n\n")
+
+	// Section 2: Worked Examples
+	printConvSection("2. Worked Examples")
+	fmt.Printf("   Each conversion below really runs T(x) on a variable, then the\n")
+	fmt.Printf("   constant form is type-checked with go/types.\n\n")
+	for _, example := range []struct{ value, source, target string }{
+		{"300", "int", "uint8"},
+		{"-1", "int", "uint32"},
+		{"200", "uint8", "int8"},
+		{"3.9", "float64", "int"},
+		{"-3.9", "float64", "int"},
+		{"1e20", "float64", "int64"},
+		{"16777217", "int", "float32"},
+		{"1e40", "float64", "float32"},
+	} {
+		printConversion(example.value, example.source, example.target)
+	}
+
+	// Section 3: Constants vs Variables
+	printConvSection("3. Constants Are Checked, Variables Are Not")
+	fmt.Printf("   const big = 300\n")
+	fmt.Printf("   var b = uint8(big)     // ❌ compile error\n\n")
+	fmt.Printf("   n := 300\n")
+	fmt.Printf("   var b = uint8(n)       // ✅ compiles, b == %d\n\n", conversionTable["int"]["uint8"](300))
+	fmt.Printf("   💡 Constant arithmetic is exact (arbitrary precision), so the\n")
+	fmt.Printf("      compiler knows when a value cannot fit and refuses it\n")
+	fmt.Printf("   💡 Variables are converted by the CPU at runtime, with no check -\n")
+	fmt.Printf("      validate ranges yourself before narrowing\n\n")
+
+	// Section 4: Interactive Explorer
+	printConvSection("4. Interactive Conversion Explorer")
+	fmt.Printf("   Enter VALUE [SOURCE] TARGET, e.g. \"300 uint8\", \"-1 int32 uint32\"\n")
+	fmt.Printf("   or \"1e20 to int64\". Without a source, integers are int and\n")
+	fmt.Printf("   anything with a '.' or exponent is float64.\n")
+	fmt.Printf("   Types: %s\n", strings.Join(convTypeNames[:5], ", "))
+	fmt.Printf("          %s\n", strings.Join(convTypeNames[5:], ", "))
+	fmt.Printf("          (byte and rune work too)\n\n")
+	for {
+		input := strings.TrimSpace(readLine("   👉 Conversion (empty line to finish): "))
+		if input == "" {
+			fmt.Println()
+			break
+		}
+		value, source, target, err := parseConversionInput(input)
+		if err != nil {
+			fmt.Printf("   ❌ %v\n\n", err)
+			continue
+		}
+		fmt.Println()
+		printConversion(value, source, target)
+	}
+
+	printConvFooter()
+}
+
+// Helper functions for demonstrations
+
+type convNumber interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// convertNumber is an ordinary T(x) conversion, compiled once per
+// source and target pair, so every result is what real Go code produces.
+func convertNumber[S, T convNumber](v any) any {
+	return T(v.(S))
+}
+
+func conversionsFrom[S convNumber]() map[string]func(any) any {
+	return map[string]func(any) any{
+		"int8":    convertNumber[S, int8],
+		"int16":   convertNumber[S, int16],
+		"int32":   convertNumber[S, int32],
+		"int64":   convertNumber[S, int64],
+		"int":     convertNumber[S, int],
+		"uint8":   convertNumber[S, uint8],
+		"uint16":  convertNumber[S, uint16],
+		"uint32":  convertNumber[S, uint32],
+		"uint64":  convertNumber[S, uint64],
+		"uint":    convertNumber[S, uint],
+		"float32": convertNumber[S, float32],
+		"float64": convertNumber[S, float64],
+	}
+}
+
+var conversionTable = map[string]map[string]func(any) any{
+	"int8":    conversionsFrom[int8](),
+	"int16":   conversionsFrom[int16](),
+	"int32":   conversionsFrom[int32](),
+	"int64":   conversionsFrom[int64](),
+	"int":     conversionsFrom[int](),
+	"uint8":   conversionsFrom[uint8](),
+	"uint16":  conversionsFrom[uint16](),
+	"uint32":  conversionsFrom[uint32](),
+	"uint64":  conversionsFrom[uint64](),
+	"uint":    conversionsFrom[uint](),
+	"float32": conversionsFrom[float32](),
+	"float64": conversionsFrom[float64](),
+}
+
+var convTypeNames = []string{
+	"int8", "int16", "int32", "int64", "int",
+	"uint8", "uint16", "uint32", "uint64", "uint",
+	"float32", "float64",
+}
+
+func canonicalTypeName(name string) (string, bool) {
+	switch name {
+	case "byte":
+		name = "uint8"
+	case "rune":
+		name = "int32"
+	}
+	_, ok := conversionTable[name]
+	return name, ok
+}
+
+func parseConversionInput(input string) (value, source, target string, err error) {
+	var fields []string
+	for _, field := range strings.Fields(input) {
+		if field != "to" && field != "→" {
+			fields = append(fields, field)
+		}
+	}
+	switch len(fields) {
+	case 2:
+		value, target = fields[0], fields[1]
+		source = defaultSourceType(value)
+	case 3:
+		value, source, target = fields[0], fields[1], fields[2]
+	default:
+		return "", "", "", fmt.Errorf("want VALUE [SOURCE] TARGET, got %q", input)
+	}
+	var ok bool
+	if source, ok = canonicalTypeName(source); !ok {
+		return "", "", "", fmt.Errorf("unknown source type %q", fields[1])
+	}
+	if target, ok = canonicalTypeName(target); !ok {
+		return "", "", "", fmt.Errorf("unknown target type %q", fields[len(fields)-1])
+	}
+	return value, source, target, nil
+}
+
+func defaultSourceType(literal string) string {
+	lower := strings.ToLower(strings.TrimLeft(literal, "+-"))
+	if strings.HasPrefix(lower, "0x") {
+		return "int"
+	}
+	if strings.ContainsAny(lower, ".e") || lower == "nan" || lower == "inf" {
+		return "float64"
+	}
+	return "int"
+}
+
+// parseSource turns the literal into a variable of the source type,
+// refusing values the source type itself cannot hold.
+func parseSource(literal, source string) (any, error) {
+	zero := reflect.Zero(typeByName(source))
+	bits := int(zero.Type().Size()) * 8
+	switch zero.Kind() {
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(literal, bits)
+		if err != nil {
+			return nil, fmt.Errorf("%s does not fit in %s", literal, source)
+		}
+		return conversionTable["float64"][source](f), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(literal, 0, bits)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid %s - try a signed or float source", literal, source)
+		}
+		return conversionTable["uint64"][source](u), nil
+	default:
+		i, err := strconv.ParseInt(literal, 0, bits)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid %s - try a wider or float source", literal, source)
+		}
+		return conversionTable["int64"][source](i), nil
+	}
+}
+
+func typeByName(name string) reflect.Type {
+	return reflect.TypeOf(conversionTable["int"][name](0))
+}
+
+// exactValue returns v as an arbitrary-precision number; ok is false for
+// NaN and infinities, which big.Float cannot hold.
+func exactValue(v reflect.Value) (value *big.Float, ok bool) {
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, false
+		}
+		return new(big.Float).SetFloat64(f), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return new(big.Float).SetUint64(v.Uint()), true
+	default:
+		return new(big.Float).SetInt64(v.Int()), true
+	}
+}
+
+func isFloatKind(kind reflect.Kind) bool {
+	return kind == reflect.Float32 || kind == reflect.Float64
+}
+
+func isSignedKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+	return false
+}
+
+// integerRange returns the smallest and largest values of an integer type.
+func integerRange(t reflect.Type) (lo, hi *big.Float) {
+	bits := uint(t.Size() * 8)
+	if isSignedKind(t.Kind()) {
+		limit := new(big.Int).Lsh(big.NewInt(1), bits-1)
+		lo = new(big.Float).SetInt(new(big.Int).Neg(limit))
+		hi = new(big.Float).SetInt(new(big.Int).Sub(limit, big.NewInt(1)))
+		return lo, hi
+	}
+	limit := new(big.Int).Lsh(big.NewInt(1), bits)
+	return new(big.Float), new(big.Float).SetInt(new(big.Int).Sub(limit, big.NewInt(1)))
+}
+
+func classifyConversion(from, to reflect.Value) (kind, detail string) {
+	fromKind, toKind := from.Kind(), to.Kind()
+	fromBits, toBits := from.Type().Size()*8, to.Type().Size()*8
+	before, finiteBefore := exactValue(from)
+	after, finiteAfter := exactValue(to)
+
+	switch {
+	case finiteBefore && finiteAfter && before.Cmp(after) == 0:
+		return "exact ✅", "the value is preserved"
+	case !finiteBefore && isFloatKind(toKind):
+		return "exact ✅", "NaN and ±Inf carry over between float types"
+	case !isFloatKind(fromKind) && !isFloatKind(toKind):
+		switch {
+		case toBits < fromBits:
+			return "wraparound 🔁", fmt.Sprintf("only the low %d bits are kept", toBits)
+		case toBits == fromBits:
+			return "wraparound 🔁", fmt.Sprintf("same %d bits, read as %s", toBits, signedness(toKind))
+		default:
+			return "wraparound 🔁", fmt.Sprintf("sign-extended to %d bits, read as unsigned", toBits)
+		}
+	case isFloatKind(fromKind) && !isFloatKind(toKind):
+		if finiteBefore {
+			truncated, _ := before.Int(nil)
+			lo, hi := integerRange(to.Type())
+			whole := new(big.Float).SetInt(truncated)
+			if whole.Cmp(lo) >= 0 && whole.Cmp(hi) <= 0 {
+				return "truncation ✂️", "the fraction is dropped (rounds toward zero)"
+			}
+		}
+		return "implementation-specific ⚠️", fmt.Sprintf("out of range for %s; this is what GOARCH=%s gives", to.Type(), runtime.GOARCH)
+	case !finiteAfter:
+		return "implementation-specific ⚠️", fmt.Sprintf("out of range for %s; this is what GOARCH=%s gives", to.Type(), runtime.GOARCH)
+	default:
+		mantissa := 24
+		if toKind == reflect.Float64 {
+			mantissa = 53
+		}
+		return "rounding 🎯", fmt.Sprintf("%s has only %d significant bits", to.Type(), mantissa)
+	}
+}
+
+func signedness(kind reflect.Kind) string {
+	if isSignedKind(kind) {
+		return "signed (two's complement)"
+	}
+	return "unsigned"
+}
+
+func bitPattern(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Float32:
+		bits := fmt.Sprintf("%032b", math.Float32bits(float32(v.Float())))
+		return bits[:1] + " " + bits[1:9] + " " + bits[9:]
+	case reflect.Float64:
+		bits := fmt.Sprintf("%064b", math.Float64bits(v.Float()))
+		return bits[:1] + " " + bits[1:12] + " " + bits[12:]
+	}
+	width := int(v.Type().Size() * 8)
+	var raw uint64
+	if isSignedKind(v.Kind()) {
+		raw = uint64(v.Int())
+	} else {
+		raw = v.Uint()
+	}
+	bits := fmt.Sprintf("%064b", raw)[64-width:]
+	var groups []string
+	for i := 0; i < len(bits); i += 8 {
+		groups = append(groups, bits[i:i+8])
+	}
+	return strings.Join(groups, " ")
+}
+
+// checkConstantConversion type-checks the same conversion written with a
+// constant, returning the snippet and the compiler's complaint if any.
+func checkConstantConversion(literal, source, target string, explicitSource bool) (string, error) {
+	snippet := fmt.Sprintf("%s(%s)", target, literal)
+	body := "var _ = " + snippet
+	if explicitSource {
+		snippet = fmt.Sprintf("const c %s = %s; %s(c)", source, literal, target)
+		body = fmt.Sprintf("const c %s = %s\nvar _ = %s(c)", source, literal, target)
+	}
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "const.go", "package p\n"+body, 0)
+	if err != nil {
+		return snippet, err
+	}
+	var firstErr error
+	conf := types.Config{Error: func(err error) {
+		if firstErr == nil {
+			firstErr = err
+		}
+	}}
+	conf.Check("p", fset, []*ast.File{file}, nil)
+	if typeErr, ok := firstErr.(types.Error); ok {
+		return snippet, fmt.Errorf("%s", typeErr.Msg)
+	}
+	return snippet, firstErr
+}
+
+// Print helper functions
+
+func printConversion(literal, source, target string) {
+	input, err := parseSource(literal, source)
+	if err != nil {
+		fmt.Printf("   ❌ %v\n\n", err)
+		return
+	}
+	result := conversionTable[source][target](input)
+	from, to := reflect.ValueOf(input), reflect.ValueOf(result)
+	kind, detail := classifyConversion(from, to)
+
+	fmt.Printf("   %s(%s(%v))\n", target, source, input)
+	fmt.Printf("   result   %v\n", result)
+	fmt.Printf("   before   %-8s %s\n", source, bitPattern(from))
+	fmt.Printf("   after    %-8s %s\n", target, bitPattern(to))
+	fmt.Printf("   kind     %s - %s\n", kind, detail)
+
+	if !from.CanFloat() || !math.IsNaN(from.Float()) && !math.IsInf(from.Float(), 0) {
+		printConstantCheck(literal, source, target)
+	} else {
+		fmt.Printf("   const    none - NaN and ±Inf cannot be written as constants\n\n")
+	}
+}
+
+func printConstantCheck(literal, source, target string) {
+	explicitSource := source != defaultSourceType(literal)
+	snippet, err := checkConstantConversion(literal, source, target, explicitSource)
+	if err != nil {
+		fmt.Printf("   const    %s ❌ %v\n\n", snippet, err)
+	} else {
+		fmt.Printf("   const    %s ✅ compiles\n\n", snippet)
+	}
+}
+
+func printConvHeader(title string) {
+	fmt.Println("\n" + strings.Repeat("=", 60))
+	fmt.Printf("  %s\n", title)
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
+
+func printConvSection(title string) {
+	fmt.Printf("┌─ %s\n", title)
+	fmt.Println("│")
+}
+
+func printConvFooter() {
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println("  ✅ Tutorial Complete!")
+	fmt.Println("  💡 Key Takeaways:")
+	fmt.Println("     • Integer narrowing keeps the low bits and wraps around")
+	fmt.Println("     • Float to integer truncates toward zero")
+	fmt.Println("     • Out-of-range float conversions are implementation-specific")
+	fmt.Println("     • Large integers lose precision when converted to floats")
+	fmt.Println("     • Constant conversions that lose information do not compile")
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
@@ -99,7 +99,8 @@ using backticks`
 	fmt.Printf("   Converting between types:\n")
 	fmt.Printf("   → int to float64: %d → %.2f\n", intNum, floatNum)
 	fmt.Printf("   → int to string:  %d → \"%s\"\n", intNum, stringNum)
-	fmt.Printf("   ⚠️  Note: Go requires explicit type conversion!\n\n")
+	fmt.Printf("   ⚠️  Note: Go requires explicit type conversion!\n")
+	fmt.Printf("   💡 The Numeric Conversions tutorial explores overflow and truncation\n\n")
 
 	// Section 8: Zero Values Summary
 	printDataSection("8. Zero Values Summary")
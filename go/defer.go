@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"strings"
+	"time"
 )
 
 func defers() {
@@ -80,7 +81,8 @@ func defers() {
 	fmt.Printf("       // ... process file ...\n")
 	fmt.Printf("       return nil\n")
 	fmt.Printf("   }\n\n")
-	fmt.Printf("   💡 defer ensures file.Close() runs even if errors occur!\n\n")
+	fmt.Printf("   💡 defer ensures file.Close() runs even if errors occur!\n")
+	fmt.Printf("      The File I/O topic runs this pattern on real files.\n\n")
 
 	// Section 7: Multiple defer Statements
 	printDeferSection("7. Multiple defer Statements (Stack Behavior)")
@@ -142,12 +144,12 @@ func defers() {
 	// Section 12: Practical Example - Timer
 	printDeferSection("12. Practical Example - Measuring Execution Time")
 	fmt.Printf("   func measureTime() {\n")
-	fmt.Printf("       start := time.Now()\n")
-	fmt.Printf("       defer func() {\n")
-	fmt.Printf("           fmt.Println(\"Took:\", time.Since(start))\n")
-	fmt.Printf("       }()\n")
+	fmt.Printf("       defer timeTrack(time.Now(), \"measureTime\")  // time.Now() runs NOW\n")
 	fmt.Printf("       // ... function logic ...\n")
 	fmt.Printf("   }\n\n")
+	fmt.Printf("   Output:\n")
+	measureTime()
+	fmt.Printf("   💡 The Time tutorial covers durations, timers and tickers\n\n")
 
 	// Section 13: Practical Example - Lock/Unlock
 	printDeferSection("13. Practical Example - Mutex Lock/Unlock")
@@ -233,6 +235,11 @@ func deferInLoopExample() {
 	}
 }
 
+func measureTime() {
+	defer timeTrack(time.Now(), "measureTime")
+	time.Sleep(25 * time.Millisecond) // Simulate work
+}
+
 func incrementExample() (result int) {
 	defer func() { result++ }()
 	return 5
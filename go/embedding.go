@@ -0,0 +1,482 @@
+package main
+
+import (
+	"embed"
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func embedding() {
+	printEmbedHeader("GO EMBEDDING AND METHOD SETS TUTORIAL")
+
+	// Section 1: Composition Recap
+	printEmbedSection("1. Composition Recap - Named Fields")
+	fmt.Printf("   The Structs tutorial nests Address as a NAMED field:\n\n")
+	fmt.Printf("   type Employee struct {\n")
+	fmt.Printf("       name    string\n")
+	fmt.Printf("       age     int\n")
+	fmt.Printf("       address Address\n")
+	fmt.Printf("   }\n\n")
+	emp := Employee{name: "Eve", age: 28, address: Address{city: "Seattle"}}
+	fmt.Printf("   emp.address.city = %q   // always spelled out in full\n\n", emp.address.city)
+	fmt.Printf("   Embedding drops the field name, and Go PROMOTES the inner\n")
+	fmt.Printf("   fields and methods to the outer type.\n\n")
+
+	// Section 2: Anonymous Embedding
+	printEmbedSection("2. Anonymous Embedding - Promoted Fields and Methods")
+	fmt.Printf("   type Animal struct {\n")
+	fmt.Printf("       name  string\n")
+	fmt.Printf("       sound string\n")
+	fmt.Printf("   }\n\n")
+	fmt.Printf("   func (a Animal) speak() string { return a.name + \" says \" + a.sound }\n\n")
+	fmt.Printf("   type Dog struct {\n")
+	fmt.Printf("       Animal        // embedded - no field name\n")
+	fmt.Printf("       breed string\n")
+	fmt.Printf("   }\n\n")
+	rex := Dog{Animal: Animal{name: "Rex", sound: "woof"}, breed: "Beagle"}
+	fmt.Printf("   rex := Dog{Animal: Animal{name: \"Rex\", sound: \"woof\"}, breed: \"Beagle\"}\n\n")
+	fmt.Printf("   rex.name        = %q   (promoted field)\n", rex.name)
+	fmt.Printf("   rex.Animal.name = %q   (explicit path still works)\n", rex.Animal.name)
+	fmt.Printf("   rex.speak()     = %q   (promoted method)\n", rex.speak())
+	fmt.Printf("   rex.fetch()     = %q   (Dog's own method)\n\n", rex.fetch())
+
+	fmt.Printf("   Promoted pointer-receiver methods work on addressable values:\n")
+	fmt.Printf("   func (a *Animal) rename(name string) { a.name = name }\n")
+	fmt.Printf("   rex.rename(\"Max\")   // really (&rex.Animal).rename(\"Max\")\n")
+	rex.rename("Max")
+	fmt.Printf("   → rex.name = %q\n\n", rex.name)
+
+	// Section 3: Shadowing
+	printEmbedSection("3. Shadowing - The Shallowest Name Wins")
+	fmt.Printf("   type Puppy struct {\n")
+	fmt.Printf("       Dog\n")
+	fmt.Printf("   }\n\n")
+	fmt.Printf("   func (p Puppy) speak() string { return p.name + \" yips!\" }\n\n")
+	pip := Puppy{Dog: Dog{Animal: Animal{name: "Pip", sound: "woof"}, breed: "Corgi"}}
+	fmt.Printf("   pip.speak()            = %q   (depth 0 - Puppy's own)\n", pip.speak())
+	fmt.Printf("   pip.Dog.speak()        = %q   (depth 1 - promoted from Animal)\n", pip.Dog.speak())
+	fmt.Printf("   pip.Dog.Animal.speak() = %q\n", pip.Dog.Animal.speak())
+	fmt.Printf("   💡 The outer method SHADOWS the embedded one - it does not override it.\n")
+	fmt.Printf("      Animal's methods never call Puppy.speak (there is no virtual dispatch).\n\n")
+
+	// Section 4: Ambiguous Selectors
+	printEmbedSection("4. Ambiguous Selectors")
+	fmt.Printf("   Embedding both lesson types from the Structs tutorial:\n\n")
+	fmt.Printf("   type StaffMember struct {\n")
+	fmt.Printf("       Person    // name, age, city\n")
+	fmt.Printf("       Address   // street, city, zipCode\n")
+	fmt.Printf("       role string\n")
+	fmt.Printf("   }\n\n")
+	staff := StaffMember{
+		Person:  Person{name: "Grace", age: 32, city: "Denver"},
+		Address: Address{street: "1 Main St", city: "Boulder", zipCode: "80301"},
+		role:    "Engineer",
+	}
+	fmt.Printf("   staff.name         = %q   (only Person has it)\n", staff.name)
+	fmt.Printf("   staff.street       = %q   (only Address has it)\n", staff.street)
+	fmt.Printf("   staff.Person.city  = %q\n", staff.Person.city)
+	fmt.Printf("   staff.Address.city = %q\n\n", staff.Address.city)
+	fmt.Printf("   staff.city - both have 'city' at the same depth. The type checker says:\n")
+	if err := typeCheckLessonSnippet("func _(staff StaffMember) { _ = staff.city }"); err != nil {
+		fmt.Printf("   ❌ %v\n", err)
+	}
+	fmt.Printf("   💡 Ambiguity is only an error when you USE the name\n\n")
+	fmt.Printf("   Methods are promoted too - LogValue comes from Person (Structured Logging):\n")
+	fmt.Printf("   staff.LogValue()   = %v\n", staff.LogValue())
+	fmt.Printf("   so slog redacts a StaffMember exactly like a Person\n\n")
+
+	// Section 5: Embedding Interfaces in Structs
+	printEmbedSection("5. Embedding an Interface in a Struct")
+	fmt.Printf("   type Notifier interface {\n")
+	fmt.Printf("       notify(msg string) string\n")
+	fmt.Printf("   }\n\n")
+	fmt.Printf("   type AlertService struct {\n")
+	fmt.Printf("       Notifier        // any implementation can be plugged in\n")
+	fmt.Printf("       name string\n")
+	fmt.Printf("   }\n\n")
+	service := AlertService{Notifier: consoleNotifier{prefix: "[console]"}, name: "billing"}
+	fmt.Printf("   service := AlertService{Notifier: consoleNotifier{prefix: \"[console]\"}}\n")
+	fmt.Printf("   service.notify(\"disk full\") = %q\n\n", service.notify("disk full"))
+
+	fmt.Printf("   Wrapping: shadow ONE method and delegate to the embedded value:\n")
+	fmt.Printf("   func (s loudNotifier) notify(msg string) string {\n")
+	fmt.Printf("       return s.Notifier.notify(strings.ToUpper(msg) + \"!\")\n")
+	fmt.Printf("   }\n")
+	loud := loudNotifier{Notifier: consoleNotifier{prefix: "[console]"}}
+	fmt.Printf("   → %q\n\n", loud.notify("disk full"))
+
+	fmt.Printf("   ⚠️  A nil embedded interface compiles but panics when called:\n")
+	var empty AlertService
+	r, _ := capturePanic(func() { empty.notify("hello") })
+	fmt.Printf("   var empty AlertService; empty.notify(\"hello\")\n")
+	fmt.Printf("   💥 panic: %v\n\n", r)
+
+	// Section 6: Embedding a Pointer
+	printEmbedSection("6. Embedding a Pointer")
+	fmt.Printf("   type RoboDog struct {\n")
+	fmt.Printf("       *Animal   // shared, not copied\n")
+	fmt.Printf("       Robot\n")
+	fmt.Printf("   }\n\n")
+	shared := &Animal{name: "Sparky", sound: "beep-woof"}
+	robo1 := RoboDog{Animal: shared, Robot: Robot{model: "RX-1"}}
+	robo2 := RoboDog{Animal: shared, Robot: Robot{model: "RX-2"}}
+	robo1.rename("Bolt")
+	fmt.Printf("   robo1 and robo2 embed the SAME *Animal\n")
+	fmt.Printf("   robo1.rename(\"Bolt\") → robo2.name = %q\n", robo2.name)
+	fmt.Printf("   robo1.charge()       = %q (promoted from Robot)\n", robo1.charge())
+	fmt.Printf("   ⚠️  Animal.speak and Robot.speak are both at depth 1, so robo1.speak()\n")
+	fmt.Printf("      would be ambiguous - call robo1.Animal.speak() or robo1.Robot.speak()\n\n")
+
+	// Section 7: Method Set Rules
+	printEmbedSection("7. Method Sets: T vs *T")
+	fmt.Printf("   ┌─────────────────────────┬──────────────────┬──────────────────┐\n")
+	fmt.Printf("   │ Method declared on      │ In method set T  │ In method set *T │\n")
+	fmt.Printf("   ├─────────────────────────┼──────────────────┼──────────────────┤\n")
+	fmt.Printf("   │ func (t T) m()          │ ✅               │ ✅               │\n")
+	fmt.Printf("   │ func (t *T) m()         │ ❌               │ ✅               │\n")
+	fmt.Printf("   │ embedded S, (S) m()     │ ✅               │ ✅               │\n")
+	fmt.Printf("   │ embedded S, (*S) m()    │ ❌               │ ✅               │\n")
+	fmt.Printf("   │ embedded *S, any m()    │ ✅               │ ✅               │\n")
+	fmt.Printf("   └─────────────────────────┴──────────────────┴──────────────────┘\n\n")
+	fmt.Printf("   Method sets decide which interfaces a type satisfies:\n")
+	fmt.Printf("   type Renamer interface { rename(name string) }\n\n")
+	for _, snippet := range []string{"var _ Renamer = &Dog{}", "var _ Renamer = Dog{}", "var _ Renamer = RoboDog{}"} {
+		if err := typeCheckLessonSnippet(snippet); err != nil {
+			fmt.Printf("   %-28s ❌ %v\n", snippet, err)
+		} else {
+			fmt.Printf("   %-28s ✅ compiles\n", snippet)
+		}
+	}
+	fmt.Printf("\n   💡 rex.rename() compiled earlier because rex is addressable -\n")
+	fmt.Printf("      calling a method is more forgiving than satisfying an interface\n\n")
+
+	// Section 8: Interactive Method Set Calculator
+	printEmbedSection("8. Interactive Method Set Calculator")
+	fmt.Printf("   Uses go/types on the Structs and Embedding lesson source to list\n")
+	fmt.Printf("   the exact method set of T and *T for any type defined there\n")
+	fmt.Printf("   (including Person.LogValue from the Structured Logging tutorial).\n\n")
+	pkg, err := loadLessonPackage(nil)
+	if err != nil {
+		fmt.Printf("   ❌ could not load lesson types: %v\n\n", err)
+	} else {
+		names := lessonTypeNames(pkg)
+		fmt.Printf("   Types: %s\n\n", strings.Join(names, ", "))
+		for {
+			input := strings.TrimSpace(readLine("   👉 Type name (empty line to finish): "))
+			if input == "" {
+				fmt.Println()
+				break
+			}
+			printMethodSets(pkg, input)
+		}
+	}
+
+	printEmbedFooter()
+}
+
+// Types for demonstrations
+
+type Animal struct {
+	name  string
+	sound string
+}
+
+func (a Animal) speak() string {
+	return a.name + " says " + a.sound
+}
+
+func (a *Animal) rename(name string) {
+	a.name = name
+}
+
+type Dog struct {
+	Animal
+	breed string
+}
+
+func (d Dog) fetch() string {
+	return d.name + " the " + d.breed + " fetches the ball"
+}
+
+type Puppy struct {
+	Dog
+}
+
+func (p Puppy) speak() string {
+	return p.name + " yips!"
+}
+
+type Robot struct {
+	model string
+}
+
+func (r Robot) speak() string {
+	return r.model + " says BEEP"
+}
+
+func (r Robot) charge() string {
+	return r.model + " is charging"
+}
+
+type RoboDog struct {
+	*Animal
+	Robot
+}
+
+type StaffMember struct {
+	Person
+	Address
+	role string
+}
+
+type Renamer interface {
+	rename(name string)
+}
+
+type Notifier interface {
+	notify(msg string) string
+}
+
+type consoleNotifier struct {
+	prefix string
+}
+
+func (c consoleNotifier) notify(msg string) string {
+	return c.prefix + " " + msg
+}
+
+type loudNotifier struct {
+	Notifier
+}
+
+func (s loudNotifier) notify(msg string) string {
+	return s.Notifier.notify(strings.ToUpper(msg) + "!")
+}
+
+type AlertService struct {
+	Notifier
+	name string
+}
+
+// Method set calculator
+
+//go:embed structs.go embedding.go logging.go
+var lessonSources embed.FS
+
+// lessonImporter resolves packages named in method signatures, such as
+// slog.Value in Person.LogValue. It is shared so imports are loaded once.
+var lessonImporter = importer.Default()
+
+// loadLessonPackage type-checks only the type and method declarations
+// from the lesson files (bodies stripped), plus the methods other
+// tutorials declare on those types, like Person.LogValue from the
+// Structured Logging tutorial. Any extra declarations are checked
+// alongside them.
+func loadLessonPackage(extra []ast.Decl) (*types.Package, error) {
+	fset := token.NewFileSet()
+	var imports, decls []ast.Decl
+	lessonTypes := map[string]bool{}
+	imported := map[string]bool{}
+	for _, name := range []string{"structs.go", "embedding.go", "logging.go"} {
+		src, err := lessonSources.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+		file, err := parser.ParseFile(fset, name, src, 0)
+		if err != nil {
+			return nil, err
+		}
+		borrowed := name == "logging.go"
+		used := map[string]bool{}
+		for _, decl := range file.Decls {
+			switch d := decl.(type) {
+			case *ast.GenDecl:
+				if d.Tok == token.TYPE && !borrowed {
+					for _, spec := range d.Specs {
+						lessonTypes[spec.(*ast.TypeSpec).Name.Name] = true
+					}
+					decls = append(decls, d)
+				}
+			case *ast.FuncDecl:
+				if d.Recv != nil && (!borrowed || lessonTypes[receiverTypeName(d)]) {
+					d.Body = nil
+					decls = append(decls, d)
+					ast.Inspect(d.Type, func(n ast.Node) bool {
+						if sel, ok := n.(*ast.SelectorExpr); ok {
+							if pkg, ok := sel.X.(*ast.Ident); ok {
+								used[pkg.Name] = true
+							}
+						}
+						return true
+					})
+				}
+			}
+		}
+		for _, spec := range file.Imports {
+			importPath, _ := strconv.Unquote(spec.Path.Value)
+			if used[path.Base(importPath)] && !imported[importPath] {
+				imported[importPath] = true
+				imports = append(imports, &ast.GenDecl{Tok: token.IMPORT, Specs: []ast.Spec{spec}})
+			}
+		}
+	}
+	decls = append(append(imports, decls...), extra...)
+
+	var firstErr error
+	conf := types.Config{Importer: lessonImporter, Error: func(err error) {
+		if firstErr == nil {
+			firstErr = err
+		}
+	}}
+	file := &ast.File{Name: ast.NewIdent("lesson"), Decls: decls}
+	pkg, _ := conf.Check("lesson", fset, []*ast.File{file}, nil)
+	return pkg, firstErr
+}
+
+// receiverTypeName returns the base type name of a method's receiver,
+// so both Person and *Person give "Person".
+func receiverTypeName(fn *ast.FuncDecl) string {
+	recv := fn.Recv.List[0].Type
+	if star, ok := recv.(*ast.StarExpr); ok {
+		recv = star.X
+	}
+	if id, ok := recv.(*ast.Ident); ok {
+		return id.Name
+	}
+	return ""
+}
+
+func typeCheckLessonSnippet(snippet string) error {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "snippet.go", "package lesson\n"+snippet, 0)
+	if err != nil {
+		return err
+	}
+	_, err = loadLessonPackage(file.Decls)
+	if typeErr, ok := err.(types.Error); ok {
+		// Keep only the final clause, e.g. "Dog does not implement Renamer (...)".
+		msg := typeErr.Msg
+		if i := strings.LastIndex(msg, ": "); i >= 0 {
+			msg = msg[i+2:]
+		}
+		return fmt.Errorf("%s", msg)
+	}
+	return err
+}
+
+func lessonTypeNames(pkg *types.Package) []string {
+	var names []string
+	for _, name := range pkg.Scope().Names() {
+		if _, ok := pkg.Scope().Lookup(name).(*types.TypeName); ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func printMethodSets(pkg *types.Package, name string) {
+	obj, ok := pkg.Scope().Lookup(strings.TrimPrefix(name, "*")).(*types.TypeName)
+	if !ok {
+		fmt.Printf("   ❌ %q is not a type in this lesson\n\n", name)
+		return
+	}
+	named := obj.Type()
+	fmt.Println()
+	fmt.Printf("   type %s %s\n", obj.Name(), types.TypeString(named.Underlying(), types.RelativeTo(pkg)))
+
+	if _, isInterface := named.Underlying().(*types.Interface); isInterface {
+		fmt.Printf("   Interface - its method set is exactly its methods:\n")
+		printMethodSet(pkg, types.NewMethodSet(named))
+		fmt.Println()
+		return
+	}
+
+	fmt.Printf("   Method set of %s:\n", obj.Name())
+	printMethodSet(pkg, types.NewMethodSet(named))
+	fmt.Printf("   Method set of *%s:\n", obj.Name())
+	printMethodSet(pkg, types.NewMethodSet(types.NewPointer(named)))
+
+	if ambiguous := ambiguousMethods(pkg, named); len(ambiguous) > 0 {
+		fmt.Printf("   ⚠️  Ambiguous (excluded from both sets): %s\n", strings.Join(ambiguous, ", "))
+	}
+	fmt.Println()
+}
+
+func printMethodSet(pkg *types.Package, set *types.MethodSet) {
+	if set.Len() == 0 {
+		fmt.Printf("      (empty)\n")
+		return
+	}
+	qualifier := types.RelativeTo(pkg)
+	for i := 0; i < set.Len(); i++ {
+		sel := set.At(i)
+		fn := sel.Obj().(*types.Func)
+		sig := fn.Type().(*types.Signature)
+		origin := "declared"
+		if sig.Recv() != nil {
+			origin = "receiver " + types.TypeString(sig.Recv().Type(), qualifier)
+		}
+		if depth := len(sel.Index()) - 1; depth > 0 {
+			origin += ", promoted from depth " + strconv.Itoa(depth)
+		}
+		signature := strings.TrimPrefix(types.TypeString(sig, qualifier), "func")
+		fmt.Printf("      %-34s %s\n", fn.Name()+signature, origin)
+	}
+}
+
+func ambiguousMethods(pkg *types.Package, named types.Type) []string {
+	candidates := map[string]bool{}
+	for _, name := range pkg.Scope().Names() {
+		typeName, ok := pkg.Scope().Lookup(name).(*types.TypeName)
+		if !ok {
+			continue
+		}
+		set := types.NewMethodSet(types.NewPointer(typeName.Type()))
+		for i := 0; i < set.Len(); i++ {
+			candidates[set.At(i).Obj().Name()] = true
+		}
+	}
+
+	var ambiguous []string
+	for name := range candidates {
+		obj, index, _ := types.LookupFieldOrMethod(named, true, pkg, name)
+		if obj == nil && index != nil {
+			ambiguous = append(ambiguous, name)
+		}
+	}
+	sort.Strings(ambiguous)
+	return ambiguous
+}
+
+// Print helper functions
+
+func printEmbedHeader(title string) {
+	fmt.Println("\n" + strings.Repeat("=", 60))
+	fmt.Printf("  %s\n", title)
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
+
+func printEmbedSection(title string) {
+	fmt.Printf("┌─ %s\n", title)
+	fmt.Println("│")
+}
+
+func printEmbedFooter() {
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println("  ✅ Tutorial Complete!")
+	fmt.Println("  💡 Key Takeaways:")
+	fmt.Println("     • Embedding promotes fields and methods to the outer type")
+	fmt.Println("     • Outer names shadow embedded ones - no virtual dispatch")
+	fmt.Println("     • Same name at the same depth is ambiguous when used")
+	fmt.Println("     • Embedded interfaces let you plug in and wrap behavior")
+	fmt.Println("     • T has value-receiver methods; *T has all methods")
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
@@ -0,0 +1,281 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+func errorHandling() {
+	printErrorHeader("GO ERROR HANDLING TUTORIAL")
+
+	// Section 1: Errors are Values
+	printErrorSection("1. Errors are Values")
+	fmt.Printf("   In Go, an error is just a value that implements:\n\n")
+	fmt.Printf("   type error interface {\n")
+	fmt.Printf("       Error() string\n")
+	fmt.Printf("   }\n\n")
+	fmt.Printf("   Remember divide() from the Functions tutorial:\n")
+	fmt.Printf("   result, err := divide(10, 0)\n")
+	_, err := divide(10, 0)
+	fmt.Printf("   → err = %v (type: %T)\n", err, err)
+	fmt.Printf("   💡 No exceptions - the caller checks err != nil\n\n")
+
+	// Section 2: Creating Errors
+	printErrorSection("2. Creating Errors")
+	fmt.Printf("   err1 := errors.New(\"connection refused\")\n")
+	err1 := errors.New("connection refused")
+	fmt.Printf("   → %v (type: %T)\n\n", err1, err1)
+
+	fmt.Printf("   err2 := fmt.Errorf(\"user %%d not found\", 42)\n")
+	err2 := fmt.Errorf("user %d not found", 42)
+	fmt.Printf("   → %v (type: %T)\n\n", err2, err2)
+
+	fmt.Printf("   ⚠️  Two errors.New calls with the same text are NOT equal:\n")
+	fmt.Printf("   errors.New(\"x\") == errors.New(\"x\") → %t\n\n",
+		errors.New("x") == errors.New("x"))
+
+	// Section 3: Sentinel Errors
+	printErrorSection("3. Sentinel Errors (Package-Level Error Values)")
+	fmt.Printf("   var errDivisionByZero = errors.New(\"division by zero\")\n\n")
+	fmt.Printf("   func divide(a, b float64) (float64, error) {\n")
+	fmt.Printf("       if b == 0 {\n")
+	fmt.Printf("           return 0, errDivisionByZero\n")
+	fmt.Printf("       }\n")
+	fmt.Printf("       return a / b, nil\n")
+	fmt.Printf("   }\n\n")
+	_, err = divide(1, 0)
+	fmt.Printf("   _, err := divide(1, 0)\n")
+	fmt.Printf("   err == errDivisionByZero → %t\n", err == errDivisionByZero)
+	fmt.Printf("   💡 Sentinels are compared by identity, so callers can react to them\n")
+	fmt.Printf("   💡 Standard library examples: io.EOF, os.ErrNotExist, sql.ErrNoRows\n\n")
+
+	// Section 4: Custom Error Types
+	printErrorSection("4. Custom Error Types")
+	fmt.Printf("   type scoreError struct {\n")
+	fmt.Printf("       index int\n")
+	fmt.Printf("       score float64\n")
+	fmt.Printf("   }\n\n")
+	fmt.Printf("   func (e *scoreError) Error() string {\n")
+	fmt.Printf("       return fmt.Sprintf(\"score #%%d is %%.1f, want 0-100\", e.index, e.score)\n")
+	fmt.Printf("   }\n\n")
+	var scoreErr error = &scoreError{index: 2, score: 140}
+	fmt.Printf("   var err error = &scoreError{index: 2, score: 140}\n")
+	fmt.Printf("   → %v (type: %T)\n", scoreErr, scoreErr)
+	fmt.Printf("   💡 Custom types carry structured data, not just a message\n\n")
+
+	// Section 5: Wrapping Errors with %w
+	printErrorSection("5. Wrapping Errors with %w")
+	fmt.Printf("   _, err := divide(total, 0)\n")
+	fmt.Printf("   wrapped := fmt.Errorf(\"average of 0 scores: %%w\", err)\n\n")
+	_, err = divide(0, 0)
+	wrapped := fmt.Errorf("average of 0 scores: %w", err)
+	fmt.Printf("   → wrapped:                %v\n", wrapped)
+	fmt.Printf("   → errors.Unwrap(wrapped): %v\n", errors.Unwrap(wrapped))
+	fmt.Printf("   → errors.Unwrap(inner):   %v\n\n", errors.Unwrap(errors.Unwrap(wrapped)))
+
+	fmt.Printf("   Compare with %%v, which only copies the text:\n")
+	flattened := fmt.Errorf("average of 0 scores: %v", err)
+	fmt.Printf("   → errors.Unwrap(flattened): %v\n", errors.Unwrap(flattened))
+	fmt.Printf("   💡 %%w keeps the original error reachable, %%v throws it away\n\n")
+
+	// Section 6: errors.Is
+	printErrorSection("6. Checking for a Sentinel with errors.Is")
+	fmt.Printf("   wrapped == errDivisionByZero            → %t\n", wrapped == errDivisionByZero)
+	fmt.Printf("   errors.Is(wrapped, errDivisionByZero)   → %t\n", errors.Is(wrapped, errDivisionByZero))
+	fmt.Printf("   errors.Is(flattened, errDivisionByZero) → %t\n", errors.Is(flattened, errDivisionByZero))
+	fmt.Printf("   💡 errors.Is walks the whole chain; == only checks the top\n\n")
+
+	// Section 7: errors.As
+	printErrorSection("7. Extracting a Custom Type with errors.As")
+	fmt.Printf("   err := fmt.Errorf(\"validate: %%w\", &scoreError{index: 2, score: 140})\n\n")
+	fmt.Printf("   var se *scoreError\n")
+	fmt.Printf("   if errors.As(err, &se) {\n")
+	fmt.Printf("       fmt.Println(se.index, se.score)\n")
+	fmt.Printf("   }\n\n")
+	validateErr := fmt.Errorf("validate: %w", scoreErr)
+	var se *scoreError
+	if errors.As(validateErr, &se) {
+		fmt.Printf("   Output: index=%d score=%.1f\n", se.index, se.score)
+	}
+	fmt.Printf("   💡 Use errors.As instead of a type assertion on wrapped errors\n\n")
+
+	// Section 8: errors.Join
+	printErrorSection("8. Combining Errors with errors.Join")
+	fmt.Printf("   err := validateScores([]float64{88, -5, 140})\n\n")
+	joined := validateScores([]float64{88, -5, 140})
+	fmt.Printf("   err.Error():\n")
+	for _, line := range strings.Split(joined.Error(), "\n") {
+		fmt.Printf("   → %s\n", line)
+	}
+	fmt.Printf("   errors.As(err, &se) → %t (finds the first match)\n", errors.As(joined, &se))
+	fmt.Printf("   💡 errors.Is and errors.As search every joined branch\n\n")
+
+	// Section 9: Handle vs Wrap vs Return
+	printErrorSection("9. Error Handling Style: Handle, Wrap or Return?")
+	fmt.Printf("   ┌──────────┬──────────────────────────────────────────────┐\n")
+	fmt.Printf("   │ Choice   │ When to use it                               │\n")
+	fmt.Printf("   ├──────────┼──────────────────────────────────────────────┤\n")
+	fmt.Printf("   │ Handle   │ You can recover: retry, default, skip, log   │\n")
+	fmt.Printf("   │ Wrap     │ You can add context the caller doesn't have  │\n")
+	fmt.Printf("   │ Return   │ Nothing useful to add - pass it up unchanged │\n")
+	fmt.Printf("   └──────────┴──────────────────────────────────────────────┘\n\n")
+	fmt.Printf("   ⚠️  Never both log AND return the same error - it gets reported twice\n")
+	fmt.Printf("   ⚠️  Wrap with %%w only when callers may inspect the cause\n\n")
+
+	// Section 10: Live Demo - Multi-Layer Call Chain
+	printErrorSection("10. Live Demo - Error Tree Through a Call Chain")
+	fmt.Printf("   handleReportRequest → buildReport → validateScores / averageScore → divide\n\n")
+	for _, student := range []string{"alice", "bob", "carol", "dave"} {
+		fmt.Printf("   handleReportRequest(%q)\n", student)
+		report, err := handleReportRequest(student)
+		if err != nil {
+			fmt.Printf("   ❌ %s\n", strings.ReplaceAll(err.Error(), "\n", "\n      "))
+			fmt.Printf("   Error tree:\n")
+			printErrorTree(err, "   ", true)
+			fmt.Printf("   errors.Is(err, errStudentNotFound) → %t\n", errors.Is(err, errStudentNotFound))
+			fmt.Printf("   errors.Is(err, errDivisionByZero)  → %t\n", errors.Is(err, errDivisionByZero))
+			var re *reportError
+			if errors.As(err, &re) {
+				fmt.Printf("   errors.As(err, &reportError)       → stage=%q\n", re.stage)
+			}
+		} else {
+			fmt.Printf("   ✅ %s\n", report)
+		}
+		fmt.Println()
+	}
+
+	printErrorFooter()
+}
+
+// Error values and types for demonstrations
+
+var errDivisionByZero = errors.New("division by zero")
+
+var errStudentNotFound = errors.New("student not found")
+
+type scoreError struct {
+	index int
+	score float64
+}
+
+func (e *scoreError) Error() string {
+	return fmt.Sprintf("score #%d is %.1f, want 0-100", e.index, e.score)
+}
+
+type reportError struct {
+	student string
+	stage   string
+	err     error
+}
+
+func (e *reportError) Error() string {
+	return fmt.Sprintf("report for %s failed at %s: %v", e.student, e.stage, e.err)
+}
+
+func (e *reportError) Unwrap() error {
+	return e.err
+}
+
+// Call chain for the live demo
+
+var studentScores = map[string][]float64{
+	"alice": {92, 85, 78},
+	"carol": {},
+	"dave":  {88, -5, 140},
+}
+
+func handleReportRequest(student string) (string, error) {
+	scores, ok := studentScores[student]
+	if !ok {
+		return "", fmt.Errorf("handle request: student %q: %w", student, errStudentNotFound)
+	}
+	report, err := buildReport(student, scores)
+	if err != nil {
+		return "", fmt.Errorf("handle request: %w", err)
+	}
+	return report, nil
+}
+
+func buildReport(student string, scores []float64) (string, error) {
+	if err := validateScores(scores); err != nil {
+		return "", &reportError{student: student, stage: "validate", err: err}
+	}
+	avg, err := averageScore(scores)
+	if err != nil {
+		return "", &reportError{student: student, stage: "average", err: err}
+	}
+	return fmt.Sprintf("%s: average %.1f over %d scores", student, avg, len(scores)), nil
+}
+
+func validateScores(scores []float64) error {
+	var errs []error
+	for i, score := range scores {
+		if score < 0 || score > 100 {
+			errs = append(errs, &scoreError{index: i, score: score})
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func averageScore(scores []float64) (float64, error) {
+	total := 0.0
+	for _, score := range scores {
+		total += score
+	}
+	avg, err := divide(total, float64(len(scores)))
+	if err != nil {
+		return 0, fmt.Errorf("average of %d scores: %w", len(scores), err)
+	}
+	return avg, nil
+}
+
+func printErrorTree(err error, prefix string, last bool) {
+	branch := "├─ "
+	childPrefix := prefix + "│  "
+	if last {
+		branch = "└─ "
+		childPrefix = prefix + "   "
+	}
+	message := strings.ReplaceAll(err.Error(), "\n", " | ")
+	fmt.Printf("%s%s%T: %s\n", prefix, branch, err, message)
+
+	var children []error
+	switch e := err.(type) {
+	case interface{ Unwrap() error }:
+		if inner := e.Unwrap(); inner != nil {
+			children = append(children, inner)
+		}
+	case interface{ Unwrap() []error }:
+		children = e.Unwrap()
+	}
+	for i, child := range children {
+		printErrorTree(child, childPrefix, i == len(children)-1)
+	}
+}
+
+// Print helper functions
+
+func printErrorHeader(title string) {
+	fmt.Println("\n" + strings.Repeat("=", 60))
+	fmt.Printf("  %s\n", title)
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
+
+func printErrorSection(title string) {
+	fmt.Printf("┌─ %s\n", title)
+	fmt.Println("│")
+}
+
+func printErrorFooter() {
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println("  ✅ Tutorial Complete!")
+	fmt.Println("  💡 Key Takeaways:")
+	fmt.Println("     • Errors are ordinary values returned as the last result")
+	fmt.Println("     • Sentinel errors are compared with errors.Is")
+	fmt.Println("     • Custom error types carry data - extract with errors.As")
+	fmt.Println("     • Wrap errors to add context without losing the cause")
+	fmt.Println("     • errors.Join reports several failures at once")
+	fmt.Println("     • Handle it, wrap it, or return it - but only once")
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
@@ -0,0 +1,437 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func fileIO() {
+	printFileHeader("GO FILE I/O TUTORIAL")
+
+	// Section 1: A Temporary Workspace
+	printFileSection("1. A Temporary Workspace")
+	fmt.Printf("   dir, err := os.MkdirTemp(\"\", \"fileio-demo-\")\n")
+	fmt.Printf("   defer os.RemoveAll(dir)   // everything below is deleted at the end\n\n")
+	dir, err := runFileDemos()
+	if err != nil {
+		fmt.Printf("   ❌ %v\n\n", err)
+	}
+	if dir == "" {
+		printFileFooter()
+		return
+	}
+
+	// Section 11: Cleanup
+	printFileSection("11. Cleanup Check")
+	if _, err := os.Stat(dir); errors.Is(err, fs.ErrNotExist) {
+		fmt.Printf("   os.Stat(%q)\n", filepath.Base(dir))
+		fmt.Printf("   ✅ gone - the deferred os.RemoveAll ran when the demo returned\n\n")
+	} else {
+		fmt.Printf("   ⚠️  %s still exists (%v)\n\n", dir, err)
+	}
+
+	printFileFooter()
+}
+
+// runFileDemos owns the temp directory, so its deferred RemoveAll runs
+// before fileIO checks that the directory is gone.
+func runFileDemos() (string, error) {
+	dir, err := os.MkdirTemp("", "fileio-demo-")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(dir)
+	fmt.Printf("   Working in %s\n\n", dir)
+
+	notes := filepath.Join(dir, "notes.txt")
+
+	// Section 2: Creating and Writing
+	printFileSection("2. Creating and Writing a File")
+	fmt.Printf("   func writeLines(path string, lines []string) (err error) {\n")
+	fmt.Printf("       f, err := os.Create(path)   // create or truncate\n")
+	fmt.Printf("       if err != nil {\n")
+	fmt.Printf("           return err\n")
+	fmt.Printf("       }\n")
+	fmt.Printf("       defer func() {\n")
+	fmt.Printf("           if closeErr := f.Close(); err == nil {\n")
+	fmt.Printf("               err = closeErr   // a failed Close can mean lost data\n")
+	fmt.Printf("           }\n")
+	fmt.Printf("       }()\n")
+	fmt.Printf("       for _, line := range lines {\n")
+	fmt.Printf("           if _, err := fmt.Fprintln(f, line); err != nil {\n")
+	fmt.Printf("               return err\n")
+	fmt.Printf("           }\n")
+	fmt.Printf("       }\n")
+	fmt.Printf("       return nil\n")
+	fmt.Printf("   }\n\n")
+	lines := []string{"Go makes file I/O simple", "defer closes the file for you", "bufio reads it line by line"}
+	if err := writeLines(notes, lines); err != nil {
+		return dir, err
+	}
+	info, err := os.Stat(notes)
+	if err != nil {
+		return dir, err
+	}
+	fmt.Printf("   writeLines(\"notes.txt\", 3 lines)\n")
+	fmt.Printf("   → %s, %d bytes, mode %v\n", info.Name(), info.Size(), info.Mode())
+	fmt.Printf("   💡 For writers, check the Close error - the named result lets\n")
+	fmt.Printf("      the deferred function report it\n\n")
+
+	// Section 3: Reading
+	printFileSection("3. Reading a File")
+	fmt.Printf("   data, err := os.ReadFile(path)   // whole file in one call\n")
+	data, err := os.ReadFile(notes)
+	if err != nil {
+		return dir, err
+	}
+	fmt.Printf("   → %q\n\n", data)
+	fmt.Printf("   Or open it yourself when you need the *os.File:\n")
+	fmt.Printf("   f, err := os.Open(path)\n")
+	fmt.Printf("   defer f.Close()\n")
+	fmt.Printf("   head := make([]byte, 9)\n")
+	fmt.Printf("   n, err := io.ReadFull(f, head)\n")
+	head, err := readHead(notes, 9)
+	if err != nil {
+		return dir, err
+	}
+	fmt.Printf("   → %d bytes: %q\n\n", len(head), head)
+
+	// Section 4: Appending
+	printFileSection("4. Appending to a File")
+	fmt.Printf("   f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o644)\n")
+	fmt.Printf("   defer func() { ... err = closeErr ... }()   // same as writeLines\n")
+	fmt.Printf("   fmt.Fprintln(f, line)\n\n")
+	for _, line := range []string{"appended line one", "appended line two"} {
+		if err := appendLine(notes, line); err != nil {
+			return dir, err
+		}
+		fmt.Printf("   appendLine(\"notes.txt\", %q)\n", line)
+	}
+	fmt.Printf("\n   ┌──────────────────┬──────────────────────────────────────┐\n")
+	fmt.Printf("   │ Flag             │ Meaning                              │\n")
+	fmt.Printf("   ├──────────────────┼──────────────────────────────────────┤\n")
+	fmt.Printf("   │ os.O_RDONLY      │ read only (what os.Open uses)        │\n")
+	fmt.Printf("   │ os.O_WRONLY      │ write only                           │\n")
+	fmt.Printf("   │ os.O_CREATE      │ create if missing                    │\n")
+	fmt.Printf("   │ os.O_TRUNC       │ empty it first (os.Create uses this) │\n")
+	fmt.Printf("   │ os.O_APPEND      │ every write goes to the end          │\n")
+	fmt.Printf("   └──────────────────┴──────────────────────────────────────┘\n\n")
+
+	// Section 5: bufio.Scanner
+	printFileSection("5. Line by Line with bufio.Scanner")
+	fmt.Printf("   scanner := bufio.NewScanner(f)\n")
+	fmt.Printf("   for scanner.Scan() {\n")
+	fmt.Printf("       line := scanner.Text()   // newline already stripped\n")
+	fmt.Printf("   }\n")
+	fmt.Printf("   err := scanner.Err()         // check after the loop\n\n")
+	numbered, err := readLines(notes)
+	if err != nil {
+		return dir, err
+	}
+	for i, line := range numbered {
+		fmt.Printf("   %2d │ %s\n", i+1, line)
+	}
+	words, err := countWords(notes)
+	if err != nil {
+		return dir, err
+	}
+	fmt.Printf("\n   scanner.Split(bufio.ScanWords) → %d words\n", words)
+	fmt.Printf("   💡 Scanner reads in chunks, so huge files never sit in memory at once\n\n")
+
+	// Section 6: io.Copy
+	printFileSection("6. Copying with io.Copy")
+	fmt.Printf("   func copyFile(dst, src string) (copied int64, err error) {\n")
+	fmt.Printf("       in, err := os.Open(src)\n")
+	fmt.Printf("       ...\n")
+	fmt.Printf("       defer closeAndReport(in)    // read-only: Close plus a log line\n")
+	fmt.Printf("       out, err := os.Create(dst)\n")
+	fmt.Printf("       ...\n")
+	fmt.Printf("       defer func() {\n")
+	fmt.Printf("           if closeErr := out.Close(); err == nil {\n")
+	fmt.Printf("               err = closeErr      // a failed flush fails the copy\n")
+	fmt.Printf("           }\n")
+	fmt.Printf("       }()\n")
+	fmt.Printf("       return io.Copy(out, in)   // streams in 32 KB chunks\n")
+	fmt.Printf("   }\n\n")
+	backup := filepath.Join(dir, "backup", "notes.bak")
+	if err := os.MkdirAll(filepath.Dir(backup), 0o755); err != nil {
+		return dir, err
+	}
+	copied, err := copyFile(backup, notes)
+	if err != nil {
+		return dir, err
+	}
+	fmt.Printf("   → copied %d bytes to backup/notes.bak\n", copied)
+	fmt.Printf("   💡 The deferred Closes ran in reverse order (LIFO), just like the\n")
+	fmt.Printf("      Defer tutorial's multipleResources example\n\n")
+
+	// Section 7: os.ReadDir
+	printFileSection("7. Listing a Directory with os.ReadDir")
+	for _, name := range []string{"src/main.go", "src/util/strings.go", "docs/README.md", ".cache/tmp.bin"} {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return dir, err
+		}
+		if err := os.WriteFile(path, []byte("// "+name+"\n"), 0o644); err != nil {
+			return dir, err
+		}
+	}
+	fmt.Printf("   entries, err := os.ReadDir(dir)   // sorted by name\n\n")
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return dir, err
+	}
+	fmt.Printf("   ┌──────────────┬──────┬─────────┐\n")
+	fmt.Printf("   │ Name         │ Kind │ Size    │\n")
+	fmt.Printf("   ├──────────────┼──────┼─────────┤\n")
+	for _, entry := range entries {
+		kind, size := "file", "-"
+		if entry.IsDir() {
+			kind = "dir"
+		} else if info, err := entry.Info(); err == nil {
+			size = fmt.Sprintf("%d B", info.Size())
+		}
+		fmt.Printf("   │ %-12s │ %-4s │ %7s │\n", entry.Name(), kind, size)
+	}
+	fmt.Printf("   └──────────────┴──────┴─────────┘\n")
+	fmt.Printf("   💡 ReadDir is not recursive - it lists one level only\n\n")
+
+	// Section 8: filepath.WalkDir
+	printFileSection("8. Walking a Tree with filepath.WalkDir")
+	fmt.Printf("   filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {\n")
+	fmt.Printf("       if d.IsDir() && strings.HasPrefix(d.Name(), \".\") {\n")
+	fmt.Printf("           return filepath.SkipDir   // don't descend into hidden dirs\n")
+	fmt.Printf("       }\n")
+	fmt.Printf("       ...\n")
+	fmt.Printf("   })\n\n")
+	if err := printFileTree(dir); err != nil {
+		return dir, err
+	}
+	fmt.Printf("\n   💡 WalkDir visits in lexical order and never follows symlinks\n\n")
+
+	// Section 9: Errors
+	printFileSection("9. File Errors")
+	_, err = os.Open(filepath.Join(dir, "missing.txt"))
+	fmt.Printf("   os.Open(\"missing.txt\")\n")
+	fmt.Printf("   → %v\n", trimFileDir(err, dir))
+	fmt.Printf("   errors.Is(err, fs.ErrNotExist) = %v\n", errors.Is(err, fs.ErrNotExist))
+	var pathErr *fs.PathError
+	if errors.As(err, &pathErr) {
+		fmt.Printf("   *fs.PathError{Op: %q, Path: \".../%s\", Err: %q}\n\n", pathErr.Op, filepath.Base(pathErr.Path), pathErr.Err)
+	}
+
+	secret := filepath.Join(dir, "secret.txt")
+	if err := os.WriteFile(secret, []byte("top secret\n"), 0o000); err != nil {
+		return dir, err
+	}
+	fmt.Printf("   os.WriteFile(\"secret.txt\", data, 0o000)   // no permissions at all\n")
+	fmt.Printf("   os.Open(\"secret.txt\")\n")
+	if f, err := os.Open(secret); err != nil {
+		fmt.Printf("   → %v\n", trimFileDir(err, dir))
+		fmt.Printf("   errors.Is(err, fs.ErrPermission) = %v\n", errors.Is(err, fs.ErrPermission))
+	} else {
+		f.Close()
+		fmt.Printf("   → opened anyway: this process runs as root (euid %d), which\n", os.Geteuid())
+		fmt.Printf("     bypasses permission bits - as a normal user this returns\n")
+		fmt.Printf("     \"permission denied\" and errors.Is(err, fs.ErrPermission) is true\n")
+	}
+
+	fmt.Printf("\n   os.ReadFile(dir)   // a directory, not a file\n")
+	_, err = os.ReadFile(dir)
+	fmt.Printf("   → %v\n\n", trimFileDir(err, dir))
+
+	// Section 10: Permissions
+	printFileSection("10. Permission Bits")
+	fmt.Printf("   ┌────────┬────────────┬──────────────────────────────┐\n")
+	fmt.Printf("   │ Octal  │ String     │ Typical use                  │\n")
+	fmt.Printf("   ├────────┼────────────┼──────────────────────────────┤\n")
+	for _, perm := range []struct {
+		octal string
+		mode  fs.FileMode
+		use   string
+	}{
+		{"0o644", 0o644, "regular file, others read"},
+		{"0o600", 0o600, "private file (keys, tokens)"},
+		{"0o755", 0o755, "directory or executable"},
+		{"0o000", 0o000, "nobody (except root)"},
+	} {
+		fmt.Printf("   │ %-6s │ %-10v │ %-28s │\n", perm.octal, perm.mode, perm.use)
+	}
+	fmt.Printf("   └────────┴────────────┴──────────────────────────────┘\n")
+	fmt.Printf("   💡 The process umask (often 022) is removed from these bits\n\n")
+
+	return dir, nil
+}
+
+// Helper functions for demonstrations
+
+func writeLines(path string, lines []string) (err error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+	for _, line := range lines {
+		if _, err := fmt.Fprintln(f, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readHead(path string, n int) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	head := make([]byte, n)
+	read, err := io.ReadFull(f, head)
+	return head[:read], err
+}
+
+func appendLine(path, line string) (err error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	_, err = fmt.Fprintln(f, line)
+	return err
+}
+
+func readLines(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	return lines, scanner.Err()
+}
+
+func countWords(path string) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Split(bufio.ScanWords)
+	count := 0
+	for scanner.Scan() {
+		count++
+	}
+	return count, scanner.Err()
+}
+
+func copyFile(dst, src string) (copied int64, err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return 0, err
+	}
+	defer closeAndReport(in)
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		closeErr := out.Close()
+		fmt.Printf("   🔒 deferred Close(%s) → err = %v (returned to the caller)\n", filepath.Base(dst), closeErr)
+		if err == nil {
+			err = closeErr
+		}
+	}()
+
+	return io.Copy(out, in)
+}
+
+// closeAndReport is only for files opened read-only, where a failed
+// Close cannot lose data.
+func closeAndReport(f *os.File) {
+	err := f.Close()
+	fmt.Printf("   🔒 deferred Close(%s) → err = %v\n", filepath.Base(f.Name()), err)
+}
+
+func printFileTree(root string) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			fmt.Printf("   %s/\n", filepath.Base(root))
+			return nil
+		}
+		depth := strings.Count(rel, string(filepath.Separator))
+		indent := strings.Repeat("│   ", depth)
+		if d.IsDir() && strings.HasPrefix(d.Name(), ".") {
+			fmt.Printf("   %s├── %s/  (skipped)\n", indent, d.Name())
+			return filepath.SkipDir
+		}
+		if d.IsDir() {
+			fmt.Printf("   %s├── %s/\n", indent, d.Name())
+		} else {
+			fmt.Printf("   %s├── %s\n", indent, d.Name())
+		}
+		return nil
+	})
+}
+
+func trimFileDir(err error, dir string) string {
+	if err == nil {
+		return "<nil>"
+	}
+	return strings.ReplaceAll(err.Error(), dir, "...")
+}
+
+// Print helper functions
+
+func printFileHeader(title string) {
+	fmt.Println("\n" + strings.Repeat("=", 60))
+	fmt.Printf("  %s\n", title)
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
+
+func printFileSection(title string) {
+	fmt.Printf("┌─ %s\n", title)
+	fmt.Println("│")
+}
+
+func printFileFooter() {
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println("  ✅ Tutorial Complete!")
+	fmt.Println("  💡 Key Takeaways:")
+	fmt.Println("     • defer f.Close() right after a successful Open/Create")
+	fmt.Println("     • Check Close errors on files you write to")
+	fmt.Println("     • bufio.Scanner reads large files line by line")
+	fmt.Println("     • io.Copy streams data without loading it all")
+	fmt.Println("     • errors.Is with fs.ErrNotExist / fs.ErrPermission")
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
@@ -0,0 +1,398 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+func formatting() {
+	printFmtHeader("GO FMT VERBS AND FORMATTING TUTORIAL")
+
+	alice := Person{name: "Alice", age: 30, city: "New York"}
+	rect := Rectangle{width: 10, height: 5}
+
+	// Section 1: General Verbs
+	printFmtSection("1. General Verbs: %v %+v %#v %T %%")
+	printFmtExamples([]fmtExample{
+		{"%v", "alice", []any{alice}, "default format"},
+		{"%+v", "alice", []any{alice}, "adds field names"},
+		{"%#v", "alice", []any{alice}, "Go syntax"},
+		{"%T", "alice", []any{alice}, "the type"},
+		{"%v", "&alice", []any{&alice}, "pointer to struct: & prefix"},
+		{"%v", "[]int{1, 2, 3}", []any{[]int{1, 2, 3}}, ""},
+		{"%#v", "[]int{1, 2, 3}", []any{[]int{1, 2, 3}}, ""},
+		{"%v", "map[string]int{...}", []any{map[string]int{"b": 2, "a": 1}}, "keys sorted"},
+		{"%v", "nil", []any{nil}, ""},
+		{"%v", "errStudentNotFound", []any{errStudentNotFound}, "calls Error()"},
+		{"100%%", "", nil, "a literal percent sign"},
+	})
+	fmt.Printf("   💡 %%v is what Println uses - every lesson so far has relied on it\n\n")
+
+	// Section 2: Booleans and Integers
+	printFmtSection("2. Booleans and Integers")
+	printFmtExamples([]fmtExample{
+		{"%t", "true", []any{true}, "bool"},
+		{"%d", "42", []any{42}, "base 10"},
+		{"%b", "42", []any{42}, "base 2"},
+		{"%o", "42", []any{42}, "base 8"},
+		{"%O", "42", []any{42}, "base 8 with 0o prefix"},
+		{"%x", "255", []any{255}, "base 16, lower case"},
+		{"%X", "255", []any{255}, "base 16, upper case"},
+		{"%c", "'G'", []any{'G'}, "the character"},
+		{"%q", "'G'", []any{'G'}, "quoted character"},
+		{"%U", "'⌘'", []any{'⌘'}, "Unicode code point"},
+		{"%#U", "'⌘'", []any{'⌘'}, "code point and character"},
+		{"%d", "-7", []any{-7}, ""},
+		{"%x", "-7", []any{-7}, "sign, then magnitude - not two's complement"},
+	})
+	fmt.Println()
+
+	// Section 3: Floating Point
+	printFmtSection("3. Floating Point and Complex Numbers")
+	printFmtExamples([]fmtExample{
+		{"%f", "3.14159", []any{3.14159}, "default precision 6"},
+		{"%.2f", "3.14159", []any{3.14159}, "precision 2"},
+		{"%e", "1234.5678", []any{1234.5678}, "scientific"},
+		{"%E", "1234.5678", []any{1234.5678}, ""},
+		{"%g", "1234.5678", []any{1234.5678}, "shortest exact form"},
+		{"%g", "1e21", []any{1e21}, "%g switches to %e for big exponents"},
+		{"%.3g", "1234.5678", []any{1234.5678}, "3 significant digits"},
+		{"%x", "1.0", []any{1.0}, "hexadecimal mantissa and exponent"},
+		{"%b", "1.0", []any{1.0}, "decimalless mantissa, binary exponent"},
+		{"%v", "2+3i", []any{2 + 3i}, "complex"},
+		{"%.1f", "2+3i", []any{2 + 3i}, "applies to both parts"},
+	})
+	fmt.Println()
+
+	// Section 4: Strings and Byte Slices
+	printFmtSection("4. Strings and Byte Slices")
+	printFmtExamples([]fmtExample{
+		{"%s", "\"héllo\"", []any{"héllo"}, "plain"},
+		{"%q", "\"héllo\"", []any{"héllo"}, "double-quoted, escaped"},
+		{"%+q", "\"héllo\"", []any{"héllo"}, "ASCII-only quoting"},
+		{"%#q", "\"héllo\"", []any{"héllo"}, "backquoted if possible"},
+		{"%x", "\"héllo\"", []any{"héllo"}, "hex of the UTF-8 bytes"},
+		{"% x", "\"héllo\"", []any{"héllo"}, "space flag separates bytes"},
+		{"%X", "[]byte(\"Go\")", []any{[]byte("Go")}, ""},
+		{"%s", "[]byte(\"Go\")", []any{[]byte("Go")}, "byte slices print as text"},
+		{"%v", "[]byte(\"Go\")", []any{[]byte("Go")}, "...unless you ask for %v"},
+	})
+	fmt.Println()
+
+	// Section 5: Pointers
+	printFmtSection("5. Pointers")
+	fmt.Printf("   fmt.Sprintf(\"%%p\", &alice)   → %p\n", &alice)
+	fmt.Printf("   fmt.Sprintf(\"%%#p\", &alice)  → %#p   (no 0x)\n", &alice)
+	fmt.Printf("   fmt.Sprintf(\"%%p\", slice)    → address of the first element\n")
+	fmt.Printf("   fmt.Sprintf(\"%%v\", &rect)    → %v   (structs get & instead)\n\n", &rect)
+
+	// Section 6: Width and Precision
+	printFmtSection("6. Width and Precision")
+	fmt.Printf("   %%[flags][width][.precision]verb - the | marks show padding.\n\n")
+	printFmtExamples([]fmtExample{
+		{"|%6d|", "42", []any{42}, "width 6, right-aligned"},
+		{"|%-6d|", "42", []any{42}, "- flag: left-aligned"},
+		{"|%06d|", "42", []any{42}, "0 flag: zero padding"},
+		{"|%8.2f|", "3.14159", []any{3.14159}, "width 8, precision 2"},
+		{"|%-8.2f|", "3.14159", []any{3.14159}, ""},
+		{"|%.3s|", "\"gopher\"", []any{"gopher"}, "precision truncates strings"},
+		{"|%8.3s|", "\"gopher\"", []any{"gopher"}, ""},
+		{"|%*d|", "5, 42", []any{5, 42}, "width from an argument"},
+		{"|%-*d|", "5, 42", []any{5, 42}, ""},
+		{"|%.*f|", "1, 3.14159", []any{1, 3.14159}, "precision from an argument"},
+		{"|%6.2v|", "[]float64{1.234, 5.678}", []any{[]float64{1.234, 5.678}}, "applied per element"},
+		{"|%7s|", "\"héllo\"", []any{"héllo"}, "width counts runes, not bytes"},
+	})
+	fmt.Printf("   💡 This is how the lessons draw their tables: %%-24s pads each\n")
+	fmt.Printf("      cell to a fixed column width\n\n")
+
+	// Section 7: Flags
+	printFmtSection("7. Flags: + # - 0 and space")
+	fmt.Printf("   ┌───────┬──────────┬────────────────────┬──────────────────────┐\n")
+	fmt.Printf("   │ Flag  │ Format   │ Value              │ Result               │\n")
+	fmt.Printf("   ├───────┼──────────┼────────────────────┼──────────────────────┤\n")
+	for _, row := range []struct {
+		flag, format, desc string
+		arg                any
+	}{
+		{"+", "%+d", "42", 42},
+		{"+", "%+.1e", "12345.0", 12345.0},
+		{"+", "%+q", "\"café\"", "café"},
+		{"+", "%+v", "rect", rect},
+		{"#", "%#x", "255", 255},
+		{"#", "%#o", "8", 8},
+		{"#", "%#X", "[]byte(\"Go\")", []byte("Go")},
+		{"#", "%#q", "\"a\\\"b\"", "a\"b"},
+		{"#", "%#g", "1.0", 1.0},
+		{"-", "%-5d|", "42", 42},
+		{"0", "%05d", "-42", -42},
+		{"0", "%08.3f", "-3.14159", -3.14159},
+		{"space", "% d", "42", 42},
+		{"space", "% x", "\"Go\"", "Go"},
+	} {
+		fmt.Printf("   │ %-5s │ %-8s │ %-18s │ %-20s │\n", row.flag, row.format, row.desc, fmt.Sprintf(row.format, row.arg))
+	}
+	fmt.Printf("   └───────┴──────────┴────────────────────┴──────────────────────┘\n\n")
+
+	// Section 8: Argument Indexes and Mistakes
+	printFmtSection("8. Argument Indexes and Formatting Mistakes")
+	printFmtExamples([]fmtExample{
+		{"%[2]d %[1]d", "1, 2", []any{1, 2}, "explicit argument index"},
+		{"%d %[1]x %[1]o", "64", []any{64}, "reuse one argument"},
+		{"%[3]*.[2]*[1]f", "12.0, 2, 6", []any{12.0, 2, 6}, "index for width and precision"},
+		{"%d", "\"hi\"", []any{"hi"}, "wrong type"},
+		{"%d %d", "1", []any{1}, "missing argument"},
+		{"%d", "1, 2", []any{1, 2}, "extra argument"},
+		{"%z", "1", []any{1}, "unknown verb"},
+	})
+	fmt.Printf("   💡 fmt never panics on a bad format - it writes %%!verb(...) into\n")
+	fmt.Printf("      the output, and go vet's printf check catches these early\n\n")
+
+	// Section 9: Stringer and GoStringer
+	printFmtSection("9. Stringer and GoStringer")
+	fmt.Printf("   type Money int64   // cents\n")
+	fmt.Printf("   func (m Money) String() string     { ... }\n")
+	fmt.Printf("   type apiKey string\n")
+	fmt.Printf("   func (k apiKey) GoString() string  { ... }\n\n")
+	price := Money(123456)
+	key := apiKey("sk-live-9f8e7d6c5b4a")
+	printFmtExamples([]fmtExample{
+		{"%v", "price", []any{price}, "String() is used"},
+		{"%s", "price", []any{price}, ""},
+		{"%d", "price", []any{price}, "%d bypasses String()"},
+		{"%v", "[]Money{99, 250}", []any{[]Money{99, 250}}, "also inside slices"},
+		{"%v", "key", []any{key}, "no String(), so raw"},
+		{"%#v", "key", []any{key}, "GoString() is used"},
+	})
+	fmt.Printf("   ⚠️  Calling Sprintf(\"%%v\", m) inside m.String() recurses forever -\n")
+	fmt.Printf("      convert first: Sprintf(\"%%d\", int64(m))\n")
+	fmt.Printf("   💡 An error's Error() wins over String() when a type has both\n\n")
+
+	// Section 10: Formatter
+	printFmtSection("10. Formatter: Full Control")
+	fmt.Printf("   func (p Point) Format(f fmt.State, verb rune) {\n")
+	fmt.Printf("       // reads flags, width and precision from f, writes to f\n")
+	fmt.Printf("   }\n\n")
+	point := Point{X: 1.5, Y: -2.25}
+	printFmtExamples([]fmtExample{
+		{"%v", "point", []any{point}, ""},
+		{"%+v", "point", []any{point}, "+ flag adds names"},
+		{"%.1f", "point", []any{point}, "precision applies to X and Y"},
+		{"|%20v|", "point", []any{point}, "width pads the whole point"},
+		{"%#v", "point", []any{point}, "handled by Format too"},
+		{"%d", "point", []any{point}, "unsupported verb"},
+	})
+	fmt.Printf("   💡 A Format method takes priority over String() and GoString(),\n")
+	fmt.Printf("      so it must handle every verb it wants to support\n\n")
+
+	// Section 11: Interactive Playground
+	printFmtSection("11. Interactive Formatting Playground")
+	fmt.Printf("   Type a format string, then one or more values separated by commas.\n")
+	fmt.Printf("   Values: numbers, true/false, \"quoted strings\", 'r' runes, or one\n")
+	fmt.Printf("   of: %s\n", strings.Join(fmtSampleNames(), ", "))
+	fmt.Printf("   Escapes like \\n and \\t work in the format string.\n\n")
+	for {
+		format := readLine("   👉 Format (empty line to finish): ")
+		if strings.TrimSpace(format) == "" {
+			fmt.Println()
+			break
+		}
+		if unquoted, err := strconv.Unquote(`"` + strings.ReplaceAll(format, `"`, `\"`) + `"`); err == nil {
+			format = unquoted
+		}
+		args := parseFmtArgs(readLine("   👉 Values: "))
+		var types []string
+		for _, arg := range args {
+			types = append(types, fmt.Sprintf("%T", arg))
+		}
+		fmt.Printf("   args   (%s)\n", strings.Join(types, ", "))
+		fmt.Printf("   result %q\n", fmt.Sprintf(format, args...))
+		fmt.Printf("   output %s\n\n", fmt.Sprintf(format, args...))
+	}
+
+	printFmtFooter()
+}
+
+// Types for demonstrations
+
+type Money int64
+
+func (m Money) String() string {
+	sign := ""
+	if m < 0 {
+		sign, m = "-", -m
+	}
+	return fmt.Sprintf("%s$%d.%02d", sign, int64(m)/100, int64(m)%100)
+}
+
+type apiKey string
+
+func (k apiKey) GoString() string {
+	if len(k) <= 4 {
+		return `apiKey("****")`
+	}
+	return fmt.Sprintf("apiKey(%q)", "****"+string(k[len(k)-4:]))
+}
+
+type Point struct {
+	X, Y float64
+}
+
+func (p Point) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v', 'f', 'g', 'e':
+	default:
+		fmt.Fprintf(f, "%%!%c(Point=%g,%g)", verb, p.X, p.Y)
+		return
+	}
+
+	// Format each coordinate with the same verb and precision, then let
+	// the width apply to the whole point.
+	coordVerb := "%" + string(verb)
+	if verb == 'v' {
+		coordVerb = "%g"
+	}
+	if precision, ok := f.Precision(); ok {
+		coordVerb = "%." + strconv.Itoa(precision) + coordVerb[1:]
+	}
+	x, y := fmt.Sprintf(coordVerb, p.X), fmt.Sprintf(coordVerb, p.Y)
+
+	var text string
+	switch {
+	case f.Flag('#'):
+		text = fmt.Sprintf("main.Point{X:%s, Y:%s}", x, y)
+	case f.Flag('+'):
+		text = fmt.Sprintf("(x=%s, y=%s)", x, y)
+	default:
+		text = fmt.Sprintf("(%s, %s)", x, y)
+	}
+	if width, ok := f.Width(); ok && len(text) < width {
+		padding := strings.Repeat(" ", width-len(text))
+		if f.Flag('-') {
+			text += padding
+		} else {
+			text = padding + text
+		}
+	}
+	fmt.Fprint(f, text)
+}
+
+// Helper functions for demonstrations
+
+type fmtExample struct {
+	format  string
+	argText string
+	args    []any
+	note    string
+}
+
+func fmtSamples() map[string]any {
+	return map[string]any{
+		"alice": Person{name: "Alice", age: 30, city: "New York"},
+		"rect":  Rectangle{width: 10, height: 5},
+		"price": Money(123456),
+		"point": Point{X: 1.5, Y: -2.25},
+		"key":   apiKey("sk-live-9f8e7d6c5b4a"),
+		"err":   errors.New("disk full"),
+		"nums":  []int{3, 1, 4, 1, 5},
+		"nil":   nil,
+	}
+}
+
+func fmtSampleNames() []string {
+	return []string{"alice", "rect", "price", "point", "key", "err", "nums", "nil"}
+}
+
+func parseFmtArgs(input string) []any {
+	samples := fmtSamples()
+	var args []any
+	for _, field := range splitFmtArgs(input) {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		if sample, ok := samples[field]; ok {
+			args = append(args, sample)
+		} else if n, err := strconv.ParseInt(field, 0, 64); err == nil {
+			args = append(args, int(n))
+		} else if f, err := strconv.ParseFloat(field, 64); err == nil {
+			args = append(args, f)
+		} else if b, err := strconv.ParseBool(field); err == nil {
+			args = append(args, b)
+		} else if r, _, tail, err := strconv.UnquoteChar(strings.Trim(field, "'"), '\''); strings.HasPrefix(field, "'") && err == nil && tail == "" {
+			args = append(args, r)
+		} else if s, err := strconv.Unquote(field); err == nil {
+			args = append(args, s)
+		} else {
+			args = append(args, field)
+		}
+	}
+	return args
+}
+
+// splitFmtArgs splits on commas that are not inside double quotes.
+func splitFmtArgs(input string) []string {
+	var fields []string
+	var current strings.Builder
+	quoted := false
+	for i := 0; i < len(input); i++ {
+		switch c := input[i]; {
+		case c == '\\' && quoted && i+1 < len(input):
+			current.WriteByte(c)
+			i++
+			current.WriteByte(input[i])
+		case c == '"':
+			quoted = !quoted
+			current.WriteByte(c)
+		case c == ',' && !quoted:
+			fields = append(fields, current.String())
+			current.Reset()
+		default:
+			current.WriteByte(c)
+		}
+	}
+	return append(fields, current.String())
+}
+
+// Print helper functions
+
+func printFmtExamples(examples []fmtExample) {
+	for _, example := range examples {
+		call := fmt.Sprintf("Sprintf(%q", example.format)
+		if example.argText != "" {
+			call += ", " + example.argText
+		}
+		call += ")"
+		result := fmt.Sprintf(example.format, example.args...)
+		line := fmt.Sprintf("   %-34s → %s", call, result)
+		if example.note != "" {
+			line = fmt.Sprintf("%-60s  %s", line, "// "+example.note)
+		}
+		fmt.Println(line)
+	}
+}
+
+func printFmtHeader(title string) {
+	fmt.Println("\n" + strings.Repeat("=", 60))
+	fmt.Printf("  %s\n", title)
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
+
+func printFmtSection(title string) {
+	fmt.Printf("┌─ %s\n", title)
+	fmt.Println("│")
+}
+
+func printFmtFooter() {
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println("  ✅ Tutorial Complete!")
+	fmt.Println("  💡 Key Takeaways:")
+	fmt.Println("     • The v, +v, #v and T verbs work on any value")
+	fmt.Println("     • Width pads, precision limits digits or characters")
+	fmt.Println("     • Flags + # - 0 and space tweak signs, prefixes and padding")
+	fmt.Println("     • Bad formats print %!verb(...) instead of panicking")
+	fmt.Println("     • Stringer, GoStringer and Formatter customize output")
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
@@ -31,6 +31,8 @@ func functions() {
 	fmt.Println("   • GetUserName()     - public function (exported)")
 	fmt.Println("   • isValid()         - boolean check")
 	fmt.Println("   • processData()     - action verb\n")
+	fmt.Printf("   💡 Exporting only matters across packages - see the\n")
+	fmt.Printf("      Packages & Modules topic for the compiler enforcing it\n\n")
 
 	// Section 3: Basic Function (No Parameters, No Return)
 	printFuncSection("3. Basic Function (No Parameters, No Return)")
@@ -273,7 +275,7 @@ func add(a int, b int) int {
 
 func divide(a, b float64) (float64, error) {
 	if b == 0 {
-		return 0, fmt.Errorf("division by zero")
+		return 0, errDivisionByZero
 	}
 	return a / b, nil
 }
@@ -0,0 +1,384 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+func httpBasics() {
+	printHTTPHeader("GO NET/HTTP TUTORIAL")
+	fmt.Printf("   Every server below is an httptest.NewServer listening on\n")
+	fmt.Printf("   127.0.0.1 with a random port - no network access needed.\n\n")
+
+	// Section 1: Handlers
+	printHTTPSection("1. Handlers and a Loopback Server")
+	fmt.Printf("   type Handler interface {\n")
+	fmt.Printf("       ServeHTTP(w http.ResponseWriter, r *http.Request)\n")
+	fmt.Printf("   }\n\n")
+	fmt.Printf("   hello := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {\n")
+	fmt.Printf("       w.Header().Set(\"Content-Type\", \"text/plain\")\n")
+	fmt.Printf("       fmt.Fprintf(w, \"Hello, %%s!\", r.URL.Query().Get(\"name\"))\n")
+	fmt.Printf("   })\n")
+	fmt.Printf("   server := httptest.NewServer(hello)\n")
+	fmt.Printf("   defer server.Close()\n\n")
+	hello := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		fmt.Fprintf(w, "Hello, %s!", r.URL.Query().Get("name"))
+	}))
+	fmt.Printf("   server.URL = %s\n", hello.URL)
+	fmt.Printf("   client := &http.Client{Timeout: 5 * time.Second}   // see section 5\n")
+	client := &http.Client{Timeout: 5 * time.Second}
+	resp, err := client.Get(hello.URL + "/?name=Gopher")
+	if err != nil {
+		fmt.Printf("   ❌ %v\n\n", err)
+	} else {
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		fmt.Printf("   client.Get(server.URL + \"/?name=Gopher\")\n")
+		fmt.Printf("   → %s, Content-Type: %s\n", resp.Status, resp.Header.Get("Content-Type"))
+		fmt.Printf("   → body: %q\n", body)
+	}
+	hello.Close()
+	fmt.Printf("   💡 Always close resp.Body, or the connection cannot be reused\n\n")
+
+	// Section 2: ServeMux Patterns
+	printHTTPSection("2. ServeMux Patterns - Methods and Wildcards")
+	fmt.Printf("   mux := http.NewServeMux()\n")
+	patterns := []string{
+		"GET /{$}",
+		"GET /users/{id}",
+		"GET /users/me",
+		"POST /users",
+		"GET /files/{path...}",
+	}
+	mux := http.NewServeMux()
+	for _, pattern := range patterns {
+		fmt.Printf("   mux.HandleFunc(%q, ...)\n", pattern)
+		mux.HandleFunc(pattern, echoPattern)
+	}
+	fmt.Println()
+	server := httptest.NewServer(mux)
+	fmt.Printf("   ┌────────────────────────┬────────┬──────────────────────────────────────────┐\n")
+	fmt.Printf("   │ Request                │ Status │ Matched pattern / PathValue              │\n")
+	fmt.Printf("   ├────────────────────────┼────────┼──────────────────────────────────────────┤\n")
+	for _, req := range []struct{ method, path string }{
+		{"GET", "/"},
+		{"GET", "/users/42"},
+		{"GET", "/users/me"},
+		{"POST", "/users"},
+		{"GET", "/files/go/intro.md"},
+		{"DELETE", "/users/42"},
+		{"GET", "/nothing/here"},
+	} {
+		status, body, header := doRequest(server.Client(), req.method, server.URL+req.path, "")
+		if status == http.StatusMethodNotAllowed {
+			body = "Allow: " + header.Get("Allow")
+		} else if status == http.StatusNotFound {
+			body = "no pattern matches"
+		}
+		fmt.Printf("   │ %-22s │ %-6d │ %-40s │\n", req.method+" "+req.path, status, body)
+	}
+	fmt.Printf("   └────────────────────────┴────────┴──────────────────────────────────────────┘\n")
+	server.Close()
+	fmt.Printf("   💡 The most specific pattern wins: /users/me beats /users/{id}\n")
+	fmt.Printf("   💡 {$} matches only the exact path; {path...} takes the rest\n")
+	fmt.Printf("   💡 Wrong method on a known path → 405 with an Allow header\n\n")
+
+	// Section 3: Middleware
+	printHTTPSection("3. Middleware Is a Closure")
+	fmt.Printf("   The Functions tutorial's multiplier returns a function that\n")
+	fmt.Printf("   remembers 'factor'. Middleware is the same idea, for handlers:\n\n")
+	fmt.Printf("   func multiplier(factor int) func(int) int\n")
+	fmt.Printf("   func requireKey(key string) func(http.Handler) http.Handler\n\n")
+	fmt.Printf("   func requireKey(key string) func(http.Handler) http.Handler {\n")
+	fmt.Printf("       return func(next http.Handler) http.Handler {\n")
+	fmt.Printf("           return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {\n")
+	fmt.Printf("               if r.Header.Get(\"X-API-Key\") != key {   // key is captured\n")
+	fmt.Printf("                   http.Error(w, \"invalid API key\", http.StatusUnauthorized)\n")
+	fmt.Printf("                   return\n")
+	fmt.Printf("               }\n")
+	fmt.Printf("               next.ServeHTTP(w, r)\n")
+	fmt.Printf("           })\n")
+	fmt.Printf("       }\n")
+	fmt.Printf("   }\n\n")
+	fmt.Printf("   handler := chain(secret, logRequests, requireKey(\"s3cret\"))\n\n")
+	secret := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "the treasure is buried under the oak")
+	})
+	server = httptest.NewServer(chain(secret, logRequests, requireKey("s3cret")))
+	for _, key := range []string{"", "wrong", "s3cret"} {
+		req, _ := http.NewRequest("GET", server.URL+"/treasure", nil)
+		if key != "" {
+			req.Header.Set("X-API-Key", key)
+		}
+		fmt.Printf("   client: GET /treasure  X-API-Key=%q\n", key)
+		resp, err := server.Client().Do(req)
+		if err != nil {
+			fmt.Printf("   ❌ %v\n", err)
+			continue
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		fmt.Printf("   client: ← %d %s\n\n", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	server.Close()
+	fmt.Printf("   💡 chain wraps right to left, so the first middleware listed\n")
+	fmt.Printf("      runs first and sees the final status on the way out\n\n")
+
+	// Section 4: JSON API
+	printHTTPSection("4. A Small JSON API")
+	fmt.Printf("   Reusing User from the JSON tutorial:\n")
+	fmt.Printf("   POST /users       → decode body, 201 Created + Location header\n")
+	fmt.Printf("   GET  /users/{id}  → 200 with JSON, or 404 as a JSON error\n\n")
+	store := &userStore{users: map[int]User{}}
+	server = httptest.NewServer(store.routes())
+	for _, call := range []struct{ method, path, body string }{
+		{"POST", "/users", `{"name": "Ann", "email": "ann@example.com", "age": 31}`},
+		{"POST", "/users", `{"name": "Ben", "email": "ben@example.com"}`},
+		{"GET", "/users/2", ""},
+		{"GET", "/users/9", ""},
+		{"POST", "/users", `{"name": "Cy", "mail": "typo@example.com"}`},
+		{"POST", "/users", `{"name": `},
+		{"GET", "/users/abc", ""},
+	} {
+		status, body, header := doRequest(server.Client(), call.method, server.URL+call.path, call.body)
+		fmt.Printf("   %s\n", strings.TrimSpace(call.method+" "+call.path+" "+call.body))
+		if location := header.Get("Location"); location != "" {
+			fmt.Printf("   ← %d  Location: %s  %s\n\n", status, location, body)
+		} else {
+			fmt.Printf("   ← %d  %s\n\n", status, body)
+		}
+	}
+	server.Close()
+	fmt.Printf("   💡 Set Content-Type before WriteHeader; headers are frozen after it\n\n")
+
+	// Section 5: Client Timeouts
+	printHTTPSection("5. Client Timeouts")
+	fmt.Printf("   ⚠️  http.DefaultClient has NO timeout - a stuck server hangs forever\n\n")
+	serverSaw := make(chan string, 1)
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		select {
+		case <-time.After(2 * time.Second):
+			fmt.Fprint(w, "finally done")
+			serverSaw <- "finished the slow work"
+		case <-r.Context().Done():
+			serverSaw <- fmt.Sprintf("r.Context() done after %v: %v", time.Since(start).Round(10*time.Millisecond), r.Context().Err())
+		}
+	}))
+
+	fmt.Printf("   client := &http.Client{Timeout: 300 * time.Millisecond}\n")
+	client = &http.Client{Timeout: 300 * time.Millisecond}
+	start := time.Now()
+	_, err = client.Get(slow.URL)
+	fmt.Printf("   client.Get(slowServer) after %v\n", time.Since(start).Round(10*time.Millisecond))
+	fmt.Printf("   → %v\n", trimHTTPURL(err, slow.URL))
+	fmt.Printf("   server: %s\n\n", <-serverSaw)
+
+	fmt.Printf("   Per-request deadline with a context:\n")
+	fmt.Printf("   ctx, cancel := context.WithTimeout(context.Background(), 150*time.Millisecond)\n")
+	fmt.Printf("   defer cancel()\n")
+	fmt.Printf("   req, _ := http.NewRequestWithContext(ctx, \"GET\", url, nil)\n")
+	ctx, cancel := context.WithTimeout(context.Background(), 150*time.Millisecond)
+	req, _ := http.NewRequestWithContext(ctx, "GET", slow.URL, nil)
+	start = time.Now()
+	_, err = slow.Client().Do(req)
+	cancel()
+	fmt.Printf("   → after %v: %v\n", time.Since(start).Round(10*time.Millisecond), trimHTTPURL(err, slow.URL))
+	fmt.Printf("   errors.Is(err, context.DeadlineExceeded) = %v\n", errors.Is(err, context.DeadlineExceeded))
+	fmt.Printf("   server: %s\n\n", <-serverSaw)
+	slow.Close()
+
+	fmt.Printf("   ┌──────────────────────────┬───────────────────────────────────┐\n")
+	fmt.Printf("   │ Setting                  │ Limits                            │\n")
+	fmt.Printf("   ├──────────────────────────┼───────────────────────────────────┤\n")
+	fmt.Printf("   │ Client.Timeout           │ whole request incl. reading body  │\n")
+	fmt.Printf("   │ context.WithTimeout      │ one request, cancels server ctx   │\n")
+	fmt.Printf("   │ Server.ReadHeaderTimeout │ slow clients sending headers      │\n")
+	fmt.Printf("   │ Server.WriteTimeout      │ time to write the response        │\n")
+	fmt.Printf("   └──────────────────────────┴───────────────────────────────────┘\n\n")
+
+	// Section 6: Testing Handlers
+	printHTTPSection("6. Testing a Handler Without a Server")
+	fmt.Printf("   rec := httptest.NewRecorder()\n")
+	fmt.Printf("   req := httptest.NewRequest(\"GET\", \"/users/1\", nil)\n")
+	fmt.Printf("   store.routes().ServeHTTP(rec, req)\n")
+	rec := httptest.NewRecorder()
+	store.routes().ServeHTTP(rec, httptest.NewRequest("GET", "/users/1", nil))
+	fmt.Printf("   → rec.Code = %d, rec.Body = %s\n", rec.Code, strings.TrimSpace(rec.Body.String()))
+	fmt.Printf("   💡 No sockets at all - ideal for the table-driven tests from the\n")
+	fmt.Printf("      Testing tutorial\n\n")
+
+	printHTTPFooter()
+}
+
+// Handlers and middleware for demonstrations
+
+func echoPattern(w http.ResponseWriter, r *http.Request) {
+	result := r.Pattern
+	for _, name := range []string{"id", "path"} {
+		if value := r.PathValue(name); value != "" {
+			result += fmt.Sprintf(" %s=%q", name, value)
+		}
+	}
+	fmt.Fprint(w, result)
+}
+
+func chain(handler http.Handler, middleware ...func(http.Handler) http.Handler) http.Handler {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		handler = middleware[i](handler)
+	}
+	return handler
+}
+
+func requireKey(key string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("X-API-Key") != key {
+				fmt.Printf("      requireKey: rejected\n")
+				http.Error(w, "invalid API key", http.StatusUnauthorized)
+				return
+			}
+			fmt.Printf("      requireKey: ok\n")
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (s *statusRecorder) WriteHeader(status int) {
+	s.status = status
+	s.ResponseWriter.WriteHeader(status)
+}
+
+func logRequests(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		fmt.Printf("      logRequests: → %s %s\n", r.Method, r.URL.Path)
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+		fmt.Printf("      logRequests: ← %d in %v\n", recorder.status, time.Since(start).Round(time.Microsecond))
+	})
+}
+
+type userStore struct {
+	mu     sync.Mutex
+	users  map[int]User
+	nextID int
+}
+
+func (s *userStore) routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /users", s.createUser)
+	mux.HandleFunc("GET /users/{id}", s.getUser)
+	return mux
+}
+
+func (s *userStore) createUser(w http.ResponseWriter, r *http.Request) {
+	var user User
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&user); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+		return
+	}
+
+	s.mu.Lock()
+	s.nextID++
+	id := s.nextID
+	s.users[id] = user
+	s.mu.Unlock()
+
+	w.Header().Set("Location", "/users/"+strconv.Itoa(id))
+	writeJSON(w, http.StatusCreated, map[string]any{"id": id, "user": user})
+}
+
+func (s *userStore) getUser(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "id must be a number"})
+		return
+	}
+
+	s.mu.Lock()
+	user, ok := s.users[id]
+	s.mu.Unlock()
+
+	if !ok {
+		writeJSON(w, http.StatusNotFound, map[string]string{"error": fmt.Sprintf("user %d not found", id)})
+		return
+	}
+	writeJSON(w, http.StatusOK, user)
+}
+
+func writeJSON(w http.ResponseWriter, status int, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(value)
+}
+
+// Helper functions for demonstrations
+
+func doRequest(client *http.Client, method, url, body string) (int, string, http.Header) {
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+	req, err := http.NewRequest(method, url, reader)
+	if err != nil {
+		return 0, err.Error(), nil
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err.Error(), nil
+	}
+	defer resp.Body.Close()
+
+	data, _ := io.ReadAll(resp.Body)
+	return resp.StatusCode, strings.TrimSpace(string(data)), resp.Header
+}
+
+func trimHTTPURL(err error, url string) string {
+	if err == nil {
+		return "<nil>"
+	}
+	return strings.ReplaceAll(err.Error(), url, "http://127.0.0.1:PORT")
+}
+
+// Print helper functions
+
+func printHTTPHeader(title string) {
+	fmt.Println("\n" + strings.Repeat("=", 60))
+	fmt.Printf("  %s\n", title)
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
+
+func printHTTPSection(title string) {
+	fmt.Printf("┌─ %s\n", title)
+	fmt.Println("│")
+}
+
+func printHTTPFooter() {
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println("  ✅ Tutorial Complete!")
+	fmt.Println("  💡 Key Takeaways:")
+	fmt.Println("     • A handler is anything with ServeHTTP(w, r)")
+	fmt.Println("     • ServeMux patterns take a method and {wildcards}")
+	fmt.Println("     • Middleware is a closure that wraps the next handler")
+	fmt.Println("     • Always set a client timeout and close resp.Body")
+	fmt.Println("     • httptest gives real servers and recorders for tests")
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
@@ -0,0 +1,386 @@
+package main
+
+import (
+	"fmt"
+	"iter"
+	"runtime"
+	"strings"
+
+	// The tutorial's own maps() and slices() lessons already use those
+	// names in package main, so the standard packages get an alias.
+	stdmaps "maps"
+	stdslices "slices"
+)
+
+func iterators() {
+	printIterHeader("GO ITERATORS TUTORIAL (RANGE-OVER-FUNC)")
+
+	// Section 1: Why Iterators?
+	printIterSection("1. Why Iterators?")
+	fmt.Printf("   go.mod declares go 1.25 and this binary runs on %s.\n", runtime.Version())
+	fmt.Printf("   Since Go 1.22/1.23, range works over more than collections:\n")
+	fmt.Printf("   ✅ Integers:            for i := range 5\n")
+	fmt.Printf("   ✅ Iterator functions:  for v := range seq\n")
+	fmt.Printf("   ✅ Standard helpers:    slices.All, maps.Keys, slices.Sorted...\n\n")
+	fmt.Printf("   Each section shows the loop from the Loops tutorial first,\n")
+	fmt.Printf("   then the iterator version, so you can compare the output.\n\n")
+
+	// Section 2: Range over Integers
+	printIterSection("2. Range over Integers (Go 1.22)")
+	fmt.Printf("   Loops tutorial, section 2:\n")
+	fmt.Printf("   for i := 0; i < 5; i++ { ... }\n")
+	fmt.Printf("   Output: ")
+	for i := 0; i < 5; i++ {
+		fmt.Printf("Count: %d ", i)
+	}
+	fmt.Printf("\n\n")
+	fmt.Printf("   Iterator version:\n")
+	fmt.Printf("   for i := range 5 { ... }\n")
+	fmt.Printf("   Output: ")
+	for i := range 5 {
+		fmt.Printf("Count: %d ", i)
+	}
+	fmt.Printf("\n   💡 Same output, no init/condition/post to get wrong\n\n")
+
+	// Section 3: iter.Seq
+	printIterSection("3. iter.Seq - A Function That Yields Values")
+	fmt.Printf("   type Seq[V any] func(yield func(V) bool)\n\n")
+	fmt.Printf("   func countdown(from int) iter.Seq[int] {\n")
+	fmt.Printf("       return func(yield func(int) bool) {\n")
+	fmt.Printf("           for i := from; i > 0; i-- {\n")
+	fmt.Printf("               if !yield(i) {\n")
+	fmt.Printf("                   return\n")
+	fmt.Printf("               }\n")
+	fmt.Printf("           }\n")
+	fmt.Printf("       }\n")
+	fmt.Printf("   }\n\n")
+	fmt.Printf("   Loops tutorial, section 3:\n")
+	fmt.Printf("   Output: ")
+	for i := 5; i > 0; i-- {
+		fmt.Printf("%d ", i)
+	}
+	fmt.Printf("Liftoff!\n\n")
+	fmt.Printf("   Iterator version: for i := range countdown(5)\n")
+	fmt.Printf("   Output: ")
+	for i := range countdown(5) {
+		fmt.Printf("%d ", i)
+	}
+	fmt.Printf("Liftoff!\n\n")
+
+	// Section 4: iter.Seq2
+	printIterSection("4. iter.Seq2 - Yielding Pairs")
+	fmt.Printf("   type Seq2[K, V any] func(yield func(K, V) bool)\n\n")
+	fruits := []string{"Apple", "Banana", "Cherry"}
+	fmt.Printf("   Loops tutorial, section 11:\n")
+	fmt.Printf("   for index, fruit := range fruits { ... }\n")
+	for index, fruit := range fruits {
+		fmt.Printf("   %d: %s\n", index, fruit)
+	}
+	fmt.Println()
+	fmt.Printf("   Iterator version - a custom Seq2 numbering from 1:\n")
+	fmt.Printf("   for n, fruit := range numbered(fruits) { ... }\n")
+	for n, fruit := range numbered(fruits) {
+		fmt.Printf("   %d: %s\n", n, fruit)
+	}
+	fmt.Println()
+
+	// Section 5: Writing Push Iterators
+	printIterSection("5. Writing Push Iterators")
+	fmt.Printf("   The iterator PUSHES values into the loop body by calling yield.\n\n")
+	fmt.Printf("   Loops tutorial, example 5 (primes up to 20):\n")
+	fmt.Printf("   Output: ")
+	for num := 2; num <= 20; num++ {
+		isPrime := true
+		for i := 2; i*i <= num; i++ {
+			if num%i == 0 {
+				isPrime = false
+				break
+			}
+		}
+		if isPrime {
+			fmt.Printf("%d ", num)
+		}
+	}
+	fmt.Printf("\n\n")
+	fmt.Printf("   Iterator version: for p := range primesUpTo(20)\n")
+	fmt.Printf("   Output: ")
+	for p := range primesUpTo(20) {
+		fmt.Printf("%d ", p)
+	}
+	fmt.Printf("\n   💡 The prime test now lives in one reusable function\n\n")
+
+	fmt.Printf("   Infinite iterators are fine - the loop decides when to stop.\n")
+	fmt.Printf("   Loops tutorial, example 3 (first 10 Fibonacci numbers):\n")
+	fmt.Printf("   Output: ")
+	a, b := 0, 1
+	for i := 0; i < 10; i++ {
+		fmt.Printf("%d ", a)
+		a, b = b, a+b
+	}
+	fmt.Printf("\n\n")
+	fmt.Printf("   Iterator version:\n")
+	fmt.Printf("   for i, f := range enumerate(fibonacci()) {\n")
+	fmt.Printf("       if i == 10 { break }\n")
+	fmt.Printf("   }\n")
+	fmt.Printf("   Output: ")
+	for i, f := range enumerate(fibonacci()) {
+		if i == 10 {
+			break
+		}
+		fmt.Printf("%d ", f)
+	}
+	fmt.Printf("\n\n")
+
+	// Section 6: Respecting yield's Return Value
+	printIterSection("6. Respecting yield's Return Value")
+	fmt.Printf("   break makes yield return false. An iterator that keeps going\n")
+	fmt.Printf("   anyway is a bug, and the runtime panics:\n\n")
+	fmt.Printf("   func badIterator(yield func(int) bool) {\n")
+	fmt.Printf("       for i := range 3 {\n")
+	fmt.Printf("           yield(i)  // ignores the result!\n")
+	fmt.Printf("       }\n")
+	fmt.Printf("   }\n\n")
+	r, _ := capturePanic(func() {
+		for v := range badIterator {
+			if v == 1 {
+				break
+			}
+		}
+	})
+	fmt.Printf("   for v := range badIterator { if v == 1 { break } }\n")
+	fmt.Printf("   💥 panic: %v\n\n", r)
+
+	// Section 7: iter.Pull
+	printIterSection("7. Converting to Pull Style with iter.Pull")
+	fmt.Printf("   Sometimes YOU want to ask for the next value, e.g. to walk\n")
+	fmt.Printf("   two sequences side by side:\n\n")
+	fmt.Printf("   next, stop := iter.Pull(fibonacci())\n")
+	fmt.Printf("   defer stop()\n")
+	fmt.Printf("   v, ok := next()\n\n")
+	next, stop := iter.Pull(fibonacci())
+	fmt.Printf("   Output:")
+	for range 5 {
+		v, ok := next()
+		fmt.Printf(" (%d, %t)", v, ok)
+	}
+	stop()
+	v, ok := next()
+	fmt.Printf("\n   After stop(): (%d, %t)\n\n", v, ok)
+
+	fmt.Printf("   Zipping two sequences with pull iterators:\n")
+	fmt.Printf("   for p, f := range zip(primesUpTo(20), fibonacci()) { ... }\n")
+	fmt.Printf("   Output:")
+	for p, f := range zip(primesUpTo(20), fibonacci()) {
+		fmt.Printf(" (%d,%d)", p, f)
+	}
+	fmt.Printf("\n   💡 Always call stop() - it releases the paused iterator\n\n")
+
+	// Section 8: Standard Iterator Helpers
+	printIterSection("8. Standard Library Iterator Helpers")
+	fmt.Printf("   slices.All(fruits)      → index, value pairs (Seq2)\n")
+	for i, fruit := range stdslices.All(fruits) {
+		fmt.Printf("      %d: %s\n", i, fruit)
+	}
+	fmt.Printf("   slices.Values(fruits)   →")
+	for fruit := range stdslices.Values(fruits) {
+		fmt.Printf(" %s", fruit)
+	}
+	fmt.Printf("\n   slices.Backward(fruits) →")
+	for _, fruit := range stdslices.Backward(fruits) {
+		fmt.Printf(" %s", fruit)
+	}
+	fmt.Printf("\n   slices.Collect(countdown(3)) → %v\n\n", stdslices.Collect(countdown(3)))
+
+	ages := map[string]int{"Alice": 25, "Bob": 30, "Charlie": 35}
+	fmt.Printf("   Loops tutorial, section 15 - map order is random:\n")
+	fmt.Printf("   for name, age := range ages { ... }\n")
+	for name, age := range ages {
+		fmt.Printf("   %s: %d\n", name, age)
+	}
+	fmt.Println()
+	fmt.Printf("   Iterator version - sorted keys, every time:\n")
+	fmt.Printf("   for _, name := range slices.Sorted(maps.Keys(ages)) { ... }\n")
+	for _, name := range stdslices.Sorted(stdmaps.Keys(ages)) {
+		fmt.Printf("   %s: %d\n", name, ages[name])
+	}
+	fmt.Println()
+	fmt.Printf("   maps.Values(ages) summed: %d\n", sumSeq(stdmaps.Values(ages)))
+	fmt.Printf("   maps.Collect(maps.All(ages)) copies: %v\n\n", stdmaps.Collect(stdmaps.All(ages)))
+
+	// Section 9: Composing Iterators
+	printIterSection("9. Composing Iterators")
+	fmt.Printf("   Small adapters chain lazily - nothing runs until the range loop:\n\n")
+	fmt.Printf("   squares := mapSeq(filterSeq(rangeSeq(10), isEven), square)\n")
+	squares := mapSeq(filterSeq(rangeSeq(10), isEven), square)
+	fmt.Printf("   slices.Collect(squares) → %v\n\n", stdslices.Collect(squares))
+
+	// Section 10: Summary
+	printIterSection("10. Summary")
+	fmt.Printf("   ┌────────────────────────┬────────────────────────────────────┐\n")
+	fmt.Printf("   │ Form                   │ Use it for                         │\n")
+	fmt.Printf("   ├────────────────────────┼────────────────────────────────────┤\n")
+	fmt.Printf("   │ for i := range n       │ Counting 0..n-1                    │\n")
+	fmt.Printf("   │ iter.Seq[V]            │ Streams of single values           │\n")
+	fmt.Printf("   │ iter.Seq2[K, V]        │ Streams of pairs (index/key+value) │\n")
+	fmt.Printf("   │ iter.Pull              │ Asking for values one at a time    │\n")
+	fmt.Printf("   │ slices/maps helpers    │ Sorting, collecting, walking       │\n")
+	fmt.Printf("   └────────────────────────┴────────────────────────────────────┘\n\n")
+
+	printIterFooter()
+}
+
+// Iterators for demonstrations
+
+func countdown(from int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := from; i > 0; i-- {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func numbered[V any](items []V) iter.Seq2[int, V] {
+	return func(yield func(int, V) bool) {
+		for i, item := range items {
+			if !yield(i+1, item) {
+				return
+			}
+		}
+	}
+}
+
+func primesUpTo(limit int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for num := 2; num <= limit; num++ {
+			isPrime := true
+			for i := 2; i*i <= num; i++ {
+				if num%i == 0 {
+					isPrime = false
+					break
+				}
+			}
+			if isPrime && !yield(num) {
+				return
+			}
+		}
+	}
+}
+
+func fibonacci() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		a, b := 0, 1
+		for {
+			if !yield(a) {
+				return
+			}
+			a, b = b, a+b
+		}
+	}
+}
+
+func enumerate[V any](seq iter.Seq[V]) iter.Seq2[int, V] {
+	return func(yield func(int, V) bool) {
+		i := 0
+		for v := range seq {
+			if !yield(i, v) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+func badIterator(yield func(int) bool) {
+	for i := range 3 {
+		yield(i)
+	}
+}
+
+func zip[A, B any](left iter.Seq[A], right iter.Seq[B]) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		nextLeft, stopLeft := iter.Pull(left)
+		defer stopLeft()
+		nextRight, stopRight := iter.Pull(right)
+		defer stopRight()
+		for {
+			a, okA := nextLeft()
+			b, okB := nextRight()
+			if !okA || !okB || !yield(a, b) {
+				return
+			}
+		}
+	}
+}
+
+func rangeSeq(n int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := range n {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func filterSeq[V any](seq iter.Seq[V], keep func(V) bool) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for v := range seq {
+			if keep(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func mapSeq[V, W any](seq iter.Seq[V], transform func(V) W) iter.Seq[W] {
+	return func(yield func(W) bool) {
+		for v := range seq {
+			if !yield(transform(v)) {
+				return
+			}
+		}
+	}
+}
+
+func sumSeq(seq iter.Seq[int]) int {
+	total := 0
+	for v := range seq {
+		total += v
+	}
+	return total
+}
+
+func isEven(n int) bool {
+	return n%2 == 0
+}
+
+func square(n int) int {
+	return n * n
+}
+
+// Print helper functions
+
+func printIterHeader(title string) {
+	fmt.Println("\n" + strings.Repeat("=", 60))
+	fmt.Printf("  %s\n", title)
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
+
+func printIterSection(title string) {
+	fmt.Printf("┌─ %s\n", title)
+	fmt.Println("│")
+}
+
+func printIterFooter() {
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println("  ✅ Tutorial Complete!")
+	fmt.Println("  💡 Key Takeaways:")
+	fmt.Println("     • for i := range n counts from 0 to n-1")
+	fmt.Println("     • iter.Seq / iter.Seq2 are functions that call yield")
+	fmt.Println("     • Stop as soon as yield returns false")
+	fmt.Println("     • iter.Pull turns a push iterator into next()/stop()")
+	fmt.Println("     • slices.Sorted(maps.Keys(m)) gives a stable map order")
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
@@ -0,0 +1,394 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+func jsonEncoding() {
+	printJSONHeader("GO ENCODING/JSON TUTORIAL")
+
+	// Section 1: The Unexported Field Trap
+	printJSONSection("1. The Unexported Field Trap")
+	fmt.Printf("   The Structs tutorial's Person has lowercase fields:\n\n")
+	fmt.Printf("   type Person struct {\n")
+	fmt.Printf("       name string\n")
+	fmt.Printf("       age  int\n")
+	fmt.Printf("       city string\n")
+	fmt.Printf("   }\n\n")
+	alice := Person{name: "Alice", age: 25, city: "New York"}
+	data, err := json.Marshal(alice)
+	fmt.Printf("   json.Marshal(Person{name: \"Alice\", age: 25, city: \"New York\"})\n")
+	fmt.Printf("   → %s   err = %v\n", data, err)
+	fmt.Printf("   ⚠️  No error - encoding/json simply cannot see unexported fields!\n\n")
+
+	fmt.Printf("   Fix: a separate type with exported, tagged fields:\n\n")
+	fmt.Printf("   type personJSON struct {\n")
+	fmt.Printf("       Name string `json:\"name\"`\n")
+	fmt.Printf("       Age  int    `json:\"age\"`\n")
+	fmt.Printf("       City string `json:\"city\"`\n")
+	fmt.Printf("   }\n\n")
+	data, _ = json.Marshal(toPersonJSON(alice))
+	fmt.Printf("   json.Marshal(toPersonJSON(alice))\n")
+	fmt.Printf("   → %s\n\n", data)
+
+	// Section 2: Struct Tags
+	printJSONSection("2. Struct Tags in Action")
+	fmt.Printf("   type User struct {\n")
+	fmt.Printf("       Name     string   `json:\"name\"`\n")
+	fmt.Printf("       Email    string   `json:\"email\"`\n")
+	fmt.Printf("       Age      int      `json:\"age,omitempty\"`\n")
+	fmt.Printf("       Tags     []string `json:\"tags,omitempty\"`\n")
+	fmt.Printf("       Password string   `json:\"-\"`\n")
+	fmt.Printf("       Admin    bool     `json:\"admin,string\"`\n")
+	fmt.Printf("   }\n\n")
+	full := User{Name: "Bob", Email: "bob@example.com", Age: 30, Tags: []string{"go", "json"}, Password: "hunter2", Admin: true}
+	sparse := User{Name: "Carol", Email: "carol@example.com", Password: "secret"}
+	data, _ = json.Marshal(full)
+	fmt.Printf("   Full:   %s\n", data)
+	data, _ = json.Marshal(sparse)
+	fmt.Printf("   Sparse: %s\n\n", data)
+	fmt.Printf("   ┌────────────────────┬────────────────────────────────────────┐\n")
+	fmt.Printf("   │ Tag                │ Effect                                 │\n")
+	fmt.Printf("   ├────────────────────┼────────────────────────────────────────┤\n")
+	fmt.Printf("   │ json:\"name\"        │ use this key instead of the field name │\n")
+	fmt.Printf("   │ json:\",omitempty\"  │ skip false, 0, \"\", nil, empty slice    │\n")
+	fmt.Printf("   │ json:\",omitzero\"   │ skip the zero value (structs too)      │\n")
+	fmt.Printf("   │ json:\",string\"     │ encode a number/bool as a JSON string  │\n")
+	fmt.Printf("   │ json:\"-\"           │ never encode or decode this field      │\n")
+	fmt.Printf("   └────────────────────┴────────────────────────────────────────┘\n\n")
+
+	fmt.Printf("   omitempty never skips a struct; omitzero does:\n")
+	data, _ = json.Marshal(shippingLabel{})
+	fmt.Printf("   shippingLabel{} → %s\n", data)
+	data, _ = json.Marshal(shippingLabel{To: addressJSON{City: "Paris"}, From: addressJSON{City: "Lyon"}})
+	fmt.Printf("   with addresses  → %s\n\n", data)
+
+	fmt.Printf("   json.MarshalIndent(full, \"   \", \"  \")\n")
+	data, _ = json.MarshalIndent(full, "   ", "  ")
+	fmt.Printf("   %s\n\n", data)
+
+	// Section 3: Unmarshal
+	printJSONSection("3. Unmarshal - JSON into Structs")
+	input := `{"NAME": "Dave", "email": "dave@example.com", "nickname": "D", "admin": "false"}`
+	var dave User
+	err = json.Unmarshal([]byte(input), &dave)
+	fmt.Printf("   input: %s\n", input)
+	fmt.Printf("   json.Unmarshal(input, &dave)\n")
+	fmt.Printf("   → %+v   err = %v\n\n", dave, err)
+	fmt.Printf("   💡 Keys match field names case-insensitively (\"NAME\" → Name)\n")
+	fmt.Printf("   💡 Unknown keys (\"nickname\") are ignored; missing ones stay zero\n")
+	fmt.Printf("   💡 Always pass a pointer - Unmarshal needs somewhere to write\n\n")
+
+	fmt.Printf("   Reject unknown keys with a Decoder:\n")
+	fmt.Printf("   dec := json.NewDecoder(strings.NewReader(input))\n")
+	fmt.Printf("   dec.DisallowUnknownFields()\n")
+	dec := json.NewDecoder(strings.NewReader(input))
+	dec.DisallowUnknownFields()
+	err = dec.Decode(&User{})
+	fmt.Printf("   → err = %v\n\n", err)
+
+	// Section 4: Unknown Shapes
+	printJSONSection("4. Decoding Unknown Shapes into map[string]any")
+	var anything map[string]any
+	err = json.Unmarshal([]byte(`{"id": 7, "price": 9.99, "tags": ["a", "b"], "meta": {"ok": true}, "note": null}`), &anything)
+	if err != nil {
+		fmt.Printf("   ❌ %v\n", err)
+	}
+	fmt.Printf("   ┌─────────┬─────────────────┬────────────────────────┐\n")
+	fmt.Printf("   │ Key     │ Go type         │ Value                  │\n")
+	fmt.Printf("   ├─────────┼─────────────────┼────────────────────────┤\n")
+	for _, key := range []string{"id", "price", "tags", "meta", "note"} {
+		goType := strings.ReplaceAll(fmt.Sprintf("%T", anything[key]), "interface {}", "any")
+		fmt.Printf("   │ %-7s │ %-15s │ %-22s │\n", key, goType, fmt.Sprint(anything[key]))
+	}
+	fmt.Printf("   └─────────┴─────────────────┴────────────────────────┘\n")
+	fmt.Printf("   ⚠️  Every JSON number becomes float64 - \"id\" is 7.0, not int 7\n")
+	fmt.Printf("      Use dec.UseNumber() to get json.Number and convert yourself\n\n")
+
+	// Section 5: Embedded Structs
+	printJSONSection("5. Embedded Structs Are Flattened")
+	fmt.Printf("   type AuditInfo struct {\n")
+	fmt.Printf("       CreatedBy string `json:\"createdBy\"`\n")
+	fmt.Printf("       Version   int    `json:\"version\"`\n")
+	fmt.Printf("   }\n\n")
+	fmt.Printf("   type Document struct {\n")
+	fmt.Printf("       AuditInfo                       // embedded → fields promoted\n")
+	fmt.Printf("       Title string `json:\"title\"`\n")
+	fmt.Printf("   }\n\n")
+	fmt.Printf("   type NestedDocument struct {\n")
+	fmt.Printf("       Audit AuditInfo `json:\"audit\"` // named → nested object\n")
+	fmt.Printf("       Title string    `json:\"title\"`\n")
+	fmt.Printf("   }\n\n")
+	audit := AuditInfo{CreatedBy: "eve", Version: 3}
+	data, _ = json.Marshal(Document{AuditInfo: audit, Title: "Go Notes"})
+	fmt.Printf("   Document       → %s\n", data)
+	data, _ = json.Marshal(NestedDocument{Audit: audit, Title: "Go Notes"})
+	fmt.Printf("   NestedDocument → %s\n", data)
+	fmt.Printf("   💡 Same promotion rules as the Embedding tutorial - and an outer\n")
+	fmt.Printf("      field with the same JSON key wins over the embedded one\n\n")
+
+	// Section 6: json.RawMessage
+	printJSONSection("6. Delayed Decoding with json.RawMessage")
+	fmt.Printf("   type Envelope struct {\n")
+	fmt.Printf("       Type    string          `json:\"type\"`\n")
+	fmt.Printf("       Payload json.RawMessage `json:\"payload\"`  // kept as raw bytes\n")
+	fmt.Printf("   }\n\n")
+	messages := []string{
+		`{"type": "click", "payload": {"x": 120, "y": 48}}`,
+		`{"type": "purchase", "payload": {"item": "book", "cents": 1999}}`,
+		`{"type": "logout", "payload": {}}`,
+	}
+	for _, message := range messages {
+		fmt.Printf("   %s\n", message)
+		fmt.Printf("   → %s\n", decodeEnvelope([]byte(message)))
+	}
+	fmt.Printf("\n   💡 Decode the envelope first, then pick the payload type from \"type\"\n\n")
+
+	// Section 7: Custom Marshalers
+	printJSONSection("7. Custom MarshalJSON / UnmarshalJSON")
+	fmt.Printf("   type Temperature float64   // stored in Celsius\n\n")
+	fmt.Printf("   func (t Temperature) MarshalJSON() ([]byte, error)    → \"21.5°C\"\n")
+	fmt.Printf("   func (t *Temperature) UnmarshalJSON(b []byte) error   ← \"21.5°C\", \"70.7°F\" or 21.5\n\n")
+	reading := Reading{Sensor: "kitchen", Temp: 21.5}
+	data, _ = json.Marshal(reading)
+	fmt.Printf("   json.Marshal(Reading{Sensor: \"kitchen\", Temp: 21.5})\n")
+	fmt.Printf("   → %s\n\n", data)
+	for _, raw := range []string{`{"sensor":"attic","temp":"30°C"}`, `{"sensor":"porch","temp":"98.6°F"}`, `{"sensor":"cellar","temp":12}`, `{"sensor":"oven","temp":"hot"}`} {
+		var r Reading
+		if err := json.Unmarshal([]byte(raw), &r); err != nil {
+			fmt.Printf("   %-38s ❌ %v\n", raw, err)
+		} else {
+			fmt.Printf("   %-38s ✅ %s = %.1f°C\n", raw, r.Sensor, float64(r.Temp))
+		}
+	}
+	fmt.Printf("\n   💡 MarshalJSON on the value receiver works for T and *T;\n")
+	fmt.Printf("      UnmarshalJSON needs a pointer receiver to modify the value\n\n")
+
+	// Section 8: Streaming
+	printJSONSection("8. Streaming with Decoder and Encoder")
+	stream := "{\"name\": \"Ann\", \"email\": \"ann@example.com\"}\n{\"name\": \"Ben\", \"email\": \"ben@example.com\", \"age\": 41}\n{\"name\": \"Cy\", \"email\": \"cy@example.com\"}\n"
+	fmt.Printf("   Newline-delimited JSON, one value at a time:\n")
+	fmt.Printf("   dec := json.NewDecoder(r)\n")
+	fmt.Printf("   for dec.More() { dec.Decode(&u) }\n\n")
+	dec = json.NewDecoder(strings.NewReader(stream))
+	for dec.More() {
+		var u User
+		if err := dec.Decode(&u); err != nil {
+			fmt.Printf("   ❌ %v\n", err)
+			break
+		}
+		fmt.Printf("   → %-4s %-16s age %d\n", u.Name, u.Email, u.Age)
+	}
+
+	fmt.Printf("\n   One big array, walked element by element with Token():\n")
+	array := `[{"name": "Dee", "email": "dee@example.com"}, {"name": "Eli", "email": "eli@example.com"}]`
+	fmt.Printf("   %s\n", array)
+	for _, line := range streamArray(array) {
+		fmt.Printf("   %s\n", line)
+	}
+
+	fmt.Printf("\n   json.NewEncoder(os.Stdout) writes each value followed by a newline:\n")
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("   ", "  ")
+	fmt.Printf("   ")
+	if err := enc.Encode(map[string]int{"apples": 3, "pears": 5}); err != nil {
+		fmt.Printf("❌ %v\n", err)
+	}
+	fmt.Printf("   💡 Map keys are sorted, so the output is deterministic\n\n")
+
+	// Section 9: Errors
+	printJSONSection("9. Decoding Errors")
+	for _, raw := range []string{`{"name": "Fay",}`, `{"name": "Gus", "age": "forty"}`, `{"name": 42}`} {
+		err := json.Unmarshal([]byte(raw), &User{})
+		fmt.Printf("   %s\n", raw)
+		fmt.Printf("   → %v\n", err)
+		var syntaxErr *json.SyntaxError
+		var typeErr *json.UnmarshalTypeError
+		switch {
+		case errors.As(err, &syntaxErr):
+			fmt.Printf("     *json.SyntaxError at byte offset %d\n\n", syntaxErr.Offset)
+		case errors.As(err, &typeErr):
+			fmt.Printf("     *json.UnmarshalTypeError: field %q wants %v, got JSON %s\n\n", typeErr.Field, typeErr.Type, typeErr.Value)
+		default:
+			fmt.Println()
+		}
+	}
+
+	printJSONFooter()
+}
+
+// Types for demonstrations
+
+type personJSON struct {
+	Name string `json:"name"`
+	Age  int    `json:"age"`
+	City string `json:"city"`
+}
+
+type User struct {
+	Name     string   `json:"name"`
+	Email    string   `json:"email"`
+	Age      int      `json:"age,omitempty"`
+	Tags     []string `json:"tags,omitempty"`
+	Password string   `json:"-"`
+	Admin    bool     `json:"admin,string"`
+}
+
+type addressJSON struct {
+	City string `json:"city"`
+}
+
+type shippingLabel struct {
+	To   addressJSON `json:"to,omitempty"`
+	From addressJSON `json:"from,omitzero"`
+}
+
+type AuditInfo struct {
+	CreatedBy string `json:"createdBy"`
+	Version   int    `json:"version"`
+}
+
+type Document struct {
+	AuditInfo
+	Title string `json:"title"`
+}
+
+type NestedDocument struct {
+	Audit AuditInfo `json:"audit"`
+	Title string    `json:"title"`
+}
+
+type Envelope struct {
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+type ClickEvent struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+type PurchaseEvent struct {
+	Item  string `json:"item"`
+	Cents int    `json:"cents"`
+}
+
+type Temperature float64
+
+func (t Temperature) MarshalJSON() ([]byte, error) {
+	return json.Marshal(strconv.FormatFloat(float64(t), 'f', -1, 64) + "°C")
+}
+
+func (t *Temperature) UnmarshalJSON(data []byte) error {
+	var number float64
+	if err := json.Unmarshal(data, &number); err == nil {
+		*t = Temperature(number)
+		return nil
+	}
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return fmt.Errorf("temperature must be a number or string: %w", err)
+	}
+	switch {
+	case strings.HasSuffix(text, "°C"):
+		celsius, err := strconv.ParseFloat(strings.TrimSuffix(text, "°C"), 64)
+		if err != nil {
+			return fmt.Errorf("bad temperature %q", text)
+		}
+		*t = Temperature(celsius)
+	case strings.HasSuffix(text, "°F"):
+		fahrenheit, err := strconv.ParseFloat(strings.TrimSuffix(text, "°F"), 64)
+		if err != nil {
+			return fmt.Errorf("bad temperature %q", text)
+		}
+		*t = Temperature((fahrenheit - 32) * 5 / 9)
+	default:
+		return fmt.Errorf("bad temperature %q: want a °C or °F suffix", text)
+	}
+	return nil
+}
+
+type Reading struct {
+	Sensor string      `json:"sensor"`
+	Temp   Temperature `json:"temp"`
+}
+
+// Helper functions for demonstrations
+
+func toPersonJSON(p Person) personJSON {
+	return personJSON{Name: p.name, Age: p.age, City: p.city}
+}
+
+func decodeEnvelope(message []byte) string {
+	var envelope Envelope
+	if err := json.Unmarshal(message, &envelope); err != nil {
+		return "❌ " + err.Error()
+	}
+	switch envelope.Type {
+	case "click":
+		var click ClickEvent
+		if err := json.Unmarshal(envelope.Payload, &click); err != nil {
+			return "❌ " + err.Error()
+		}
+		return fmt.Sprintf("ClickEvent%+v", click)
+	case "purchase":
+		var purchase PurchaseEvent
+		if err := json.Unmarshal(envelope.Payload, &purchase); err != nil {
+			return "❌ " + err.Error()
+		}
+		return fmt.Sprintf("PurchaseEvent%+v", purchase)
+	default:
+		return fmt.Sprintf("⚠️  unknown type %q, raw payload kept: %s", envelope.Type, envelope.Payload)
+	}
+}
+
+func streamArray(input string) []string {
+	var lines []string
+	dec := json.NewDecoder(strings.NewReader(input))
+	token, err := dec.Token()
+	if err != nil {
+		return []string{"❌ " + err.Error()}
+	}
+	lines = append(lines, fmt.Sprintf("→ Token() = %v  (json.Delim)", token))
+	for dec.More() {
+		var u User
+		if err := dec.Decode(&u); err != nil {
+			return append(lines, "❌ "+err.Error())
+		}
+		lines = append(lines, fmt.Sprintf("→ Decode() = %s <%s>", u.Name, u.Email))
+	}
+	if token, err = dec.Token(); err == nil {
+		lines = append(lines, fmt.Sprintf("→ Token() = %v", token))
+	}
+	return lines
+}
+
+// Print helper functions
+
+func printJSONHeader(title string) {
+	fmt.Println("\n" + strings.Repeat("=", 60))
+	fmt.Printf("  %s\n", title)
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
+
+func printJSONSection(title string) {
+	fmt.Printf("┌─ %s\n", title)
+	fmt.Println("│")
+}
+
+func printJSONFooter() {
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println("  ✅ Tutorial Complete!")
+	fmt.Println("  💡 Key Takeaways:")
+	fmt.Println("     • Only exported fields are encoded - lowercase gives {}")
+	fmt.Println("     • Tags rename keys; omitempty/omitzero skip empty values")
+	fmt.Println("     • Embedded structs are flattened into the parent object")
+	fmt.Println("     • json.RawMessage delays decoding until you know the type")
+	fmt.Println("     • Decoder/Encoder stream values instead of whole documents")
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
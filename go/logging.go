@@ -0,0 +1,373 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	stdslices "slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+func structuredLogging() {
+	printLogHeader("GO STRUCTURED LOGGING TUTORIAL")
+
+	out := &indentWriter{w: os.Stdout, prefix: "   "}
+	noTime := &slog.HandlerOptions{ReplaceAttr: dropTime}
+
+	// Section 1: The log Package
+	printLogSection("1. The Classic log Package")
+	logger := log.New(out, "app: ", log.Ldate|log.Ltime|log.Lmsgprefix)
+	fmt.Printf("   logger := log.New(w, \"app: \", log.Ldate|log.Ltime|log.Lmsgprefix)\n")
+	logger.Printf("user %s logged in from %s after %d attempts", "alice", "10.0.0.7", 3)
+	logger.SetFlags(log.Lshortfile)
+	fmt.Printf("   logger.SetFlags(log.Lshortfile)\n")
+	logger.Println("cache miss")
+	fmt.Printf("   ⚠️  log.Fatal calls os.Exit(1) and log.Panic panics - avoid them in\n")
+	fmt.Printf("      libraries, deferred functions will not run after Fatal\n")
+	fmt.Printf("   💡 One formatted string per line is easy to read but hard to\n")
+	fmt.Printf("      search: \"which logins needed more than 2 attempts?\"\n\n")
+
+	// Section 2: slog Text Handler
+	printLogSection("2. log/slog with a Text Handler")
+	fmt.Printf("   logger := slog.New(slog.NewTextHandler(w, nil))\n")
+	fmt.Printf("   logger.Info(\"user logged in\", \"user\", \"alice\", \"ip\", \"10.0.0.7\", \"attempts\", 3)\n\n")
+	slog.New(slog.NewTextHandler(out, nil)).Info("user logged in", "user", "alice", "ip", "10.0.0.7", "attempts", 3)
+	fmt.Printf("\n   The message stays constant; the details become key=value pairs.\n")
+	fmt.Printf("   (The rest of this topic drops the time attribute with ReplaceAttr\n")
+	fmt.Printf("   so the output stays short.)\n\n")
+
+	// Section 3: JSON Handler
+	printLogSection("3. JSON Handler")
+	fmt.Printf("   logger := slog.New(slog.NewJSONHandler(w, opts))\n\n")
+	jsonLogger := slog.New(slog.NewJSONHandler(out, noTime))
+	jsonLogger.Info("user logged in", "user", "alice", "ip", "10.0.0.7", "attempts", 3)
+	jsonLogger.Warn("disk almost full", "mount", "/var", "used_pct", 91.5)
+	fmt.Printf("\n   💡 Same calls, different handler - JSON is what log pipelines ingest\n\n")
+
+	// Section 4: Attributes
+	printLogSection("4. Attributes")
+	textLogger := slog.New(slog.NewTextHandler(out, noTime))
+	fmt.Printf("   Typed attribute constructors avoid guessing and allocations:\n")
+	textLogger.Info("order placed",
+		slog.String("customer", "alice"),
+		slog.Int("items", 3),
+		slog.Float64("total", 59.97),
+		slog.Bool("express", true),
+		slog.Duration("checkout_time", 1500*time.Millisecond),
+		slog.Any("tags", []string{"gift", "sale"}),
+	)
+	fmt.Printf("\n   LogAttrs takes only Attrs, the fastest form:\n")
+	textLogger.LogAttrs(context.Background(), slog.LevelInfo, "cache hit", slog.String("key", "user:42"))
+	fmt.Printf("\n   An odd key/value list produces !BADKEY:\n")
+	oddArgs := []any{"card_declined"}
+	textLogger.Info("payment failed", oddArgs...)
+	fmt.Printf("   💡 go vet's slog check catches mismatched key/value pairs when\n")
+	fmt.Printf("      they are written out literally\n\n")
+
+	// Section 5: Groups
+	printLogSection("5. Groups")
+	fmt.Printf("   slog.Group(\"request\", \"method\", \"GET\", \"path\", \"/users/42\")\n\n")
+	request := slog.Group("request", "method", "GET", "path", "/users/42")
+	textLogger.Info("handled", request, "status", 200)
+	jsonLogger.Info("handled", request, "status", 200)
+	fmt.Printf("\n   logger.WithGroup(\"http\") nests everything logged afterwards:\n")
+	jsonLogger.WithGroup("http").Info("handled", "status", 200, "bytes", 512)
+	fmt.Println()
+
+	// Section 6: Levels
+	printLogSection("6. Levels")
+	var level slog.LevelVar
+	leveled := slog.New(slog.NewTextHandler(out, &slog.HandlerOptions{Level: &level, ReplaceAttr: renameLevels}))
+	fmt.Printf("   Debug=%d  Info=%d  Warn=%d  Error=%d  (custom NOTICE=%d)\n\n",
+		slog.LevelDebug, slog.LevelInfo, slog.LevelWarn, slog.LevelError, levelNotice)
+	logAllLevels := func() {
+		leveled.Debug("cache state", "entries", 128)
+		leveled.Info("server started", "port", 8080)
+		leveled.Log(context.Background(), levelNotice, "config reloaded")
+		leveled.Warn("slow query", "ms", 870)
+		leveled.Error("upstream down", "service", "billing")
+	}
+	fmt.Printf("   Default level (Info):\n")
+	logAllLevels()
+	level.Set(slog.LevelDebug)
+	fmt.Printf("\n   level.Set(slog.LevelDebug) - changed at runtime via LevelVar:\n")
+	logAllLevels()
+	level.Set(slog.LevelWarn)
+	fmt.Printf("\n   level.Set(slog.LevelWarn):\n")
+	logAllLevels()
+	fmt.Printf("   💡 Disabled calls return early, but arguments are still evaluated -\n")
+	fmt.Printf("      guard expensive ones with logger.Enabled(ctx, level)\n\n")
+
+	// Section 7: With
+	printLogSection("7. Adding Context with With")
+	fmt.Printf("   reqLogger := logger.With(\"request_id\", \"r-1042\", \"user\", \"alice\")\n\n")
+	reqLogger := textLogger.With("request_id", "r-1042", "user", "alice")
+	reqLogger.Info("loading cart")
+	reqLogger.Info("applying coupon", "code", "SPRING10")
+	reqLogger.Warn("coupon expired", "code", "SPRING10")
+	fmt.Printf("\n   In an HTTP middleware each request gets its own child logger:\n")
+	handler := slogRequests(textLogger, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestLogger(r.Context()).Info("fetching user", "id", r.PathValue("id"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	mux := http.NewServeMux()
+	mux.Handle("GET /users/{id}", handler)
+	for _, path := range []string{"/users/7", "/users/9"} {
+		mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", path, nil))
+	}
+	fmt.Printf("   💡 With pre-formats its attributes once, so child loggers are cheap\n\n")
+
+	// Section 8: LogValuer
+	printLogSection("8. Redacting Sensitive Data with LogValuer")
+	alice := Person{name: "Alice Johnson", age: 34, city: "New York"}
+	eve := Employee{name: "Eve Adams", age: 28, address: Address{street: "1 Main St", city: "Boston", zipCode: "02101"}}
+	fmt.Printf("   Employee has no LogValue method, so everything leaks:\n")
+	textLogger.Info("employee updated", "employee", eve)
+	fmt.Printf("\n   func (p Person) LogValue() slog.Value {\n")
+	fmt.Printf("       return slog.GroupValue(\n")
+	fmt.Printf("           slog.String(\"name\", maskName(p.name)),\n")
+	fmt.Printf("           slog.String(\"age_range\", ageRange(p.age)))   // city omitted\n")
+	fmt.Printf("   }\n\n")
+	textLogger.Info("profile viewed", "person", alice)
+	jsonLogger.Info("profile viewed", "person", alice)
+	fmt.Printf("   💡 The handler calls LogValue only when the record is written, so\n")
+	fmt.Printf("      redaction applies everywhere Person is logged\n\n")
+
+	// Section 9: Custom Handler
+	printLogSection("9. Writing a Custom Handler")
+	fmt.Printf("   A Handler needs four methods:\n")
+	fmt.Printf("   Enabled(ctx, level) bool       - filter early\n")
+	fmt.Printf("   Handle(ctx, record) error      - format and write one record\n")
+	fmt.Printf("   WithAttrs(attrs) Handler       - return a copy with extra attrs\n")
+	fmt.Printf("   WithGroup(name) Handler        - return a copy with a group prefix\n\n")
+	fmt.Printf("   lessonHandler prints records like the rest of this tutorial:\n\n")
+	pretty := slog.New(newLessonHandler(os.Stdout, slog.LevelDebug))
+	pretty.Debug("warming cache", "entries", 128)
+	pretty.Info("server started", "port", 8080)
+	pretty.With("request_id", "r-7").WithGroup("db").Warn("slow query", "table", "orders", "ms", 870)
+	pretty.Error("upstream down", "person", alice, slog.Group("retry", "attempt", 3, "backoff", 2*time.Second))
+	fmt.Printf("\n   💡 Handlers must be safe for concurrent use - lessonHandler\n")
+	fmt.Printf("      shares one mutex between all its copies\n\n")
+
+	// Section 10: Bridging log and slog
+	printLogSection("10. Routing the log Package Through slog")
+	previous, previousWriter, previousFlags := slog.Default(), log.Writer(), log.Flags()
+	slog.SetDefault(slog.New(newLessonHandler(os.Stdout, slog.LevelInfo)))
+	fmt.Printf("   slog.SetDefault(slog.New(lessonHandler))\n")
+	fmt.Printf("   log.Printf(\"legacy code says %%s\", \"hello\")\n")
+	log.Printf("legacy code says %s", "hello")
+	fmt.Printf("   slog.Info(\"top-level helpers use the default too\")\n")
+	slog.Info("top-level helpers use the default too")
+	slog.SetDefault(previous)
+	log.SetOutput(previousWriter)
+	log.SetFlags(previousFlags)
+	fmt.Printf("   💡 Old log.Printf calls become INFO records, so a service can\n")
+	fmt.Printf("      migrate to slog one package at a time\n\n")
+
+	printLogFooter()
+}
+
+// Helper functions for demonstrations
+
+// indentWriter prefixes every line so handler output lines up with the
+// rest of the tutorial.
+type indentWriter struct {
+	w      io.Writer
+	prefix string
+}
+
+func (iw *indentWriter) Write(p []byte) (int, error) {
+	lines := bytes.SplitAfter(p, []byte("\n"))
+	for _, line := range lines {
+		if len(line) == 0 {
+			continue
+		}
+		if _, err := io.WriteString(iw.w, iw.prefix); err != nil {
+			return 0, err
+		}
+		if _, err := iw.w.Write(line); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+func dropTime(groups []string, a slog.Attr) slog.Attr {
+	if a.Key == slog.TimeKey && len(groups) == 0 {
+		return slog.Attr{}
+	}
+	return a
+}
+
+const levelNotice = slog.Level(2)
+
+func renameLevels(groups []string, a slog.Attr) slog.Attr {
+	if a.Key == slog.LevelKey && len(groups) == 0 {
+		if level, ok := a.Value.Any().(slog.Level); ok && level == levelNotice {
+			a.Value = slog.StringValue("NOTICE")
+		}
+	}
+	return dropTime(groups, a)
+}
+
+type loggerKey struct{}
+
+func requestLogger(ctx context.Context) *slog.Logger {
+	if logger, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok {
+		return logger
+	}
+	return slog.Default()
+}
+
+func slogRequests(logger *slog.Logger, next http.Handler) http.Handler {
+	var mu sync.Mutex
+	count := 0
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		count++
+		id := fmt.Sprintf("req-%03d", count)
+		mu.Unlock()
+
+		reqLogger := logger.With("request_id", id, "method", r.Method, "path", r.URL.Path)
+		start := time.Now()
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), loggerKey{}, reqLogger)))
+		reqLogger.Info("request done", "took", time.Since(start).Round(time.Millisecond))
+	})
+}
+
+func (p Person) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("name", maskName(p.name)),
+		slog.String("age_range", ageRange(p.age)),
+	)
+}
+
+func maskName(name string) string {
+	var masked []string
+	for _, word := range strings.Fields(name) {
+		first := []rune(word)[:1]
+		masked = append(masked, string(first)+"***")
+	}
+	return strings.Join(masked, " ")
+}
+
+func ageRange(age int) string {
+	decade := age / 10 * 10
+	return fmt.Sprintf("%d-%d", decade, decade+9)
+}
+
+// lessonHandler is a minimal slog.Handler that prints one emoji-tagged
+// line per record.
+type lessonHandler struct {
+	w      io.Writer
+	level  slog.Leveler
+	mu     *sync.Mutex
+	attrs  []slog.Attr
+	groups []string
+}
+
+func newLessonHandler(w io.Writer, level slog.Leveler) *lessonHandler {
+	return &lessonHandler{w: w, level: level, mu: &sync.Mutex{}}
+}
+
+func (h *lessonHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.level.Level()
+}
+
+func (h *lessonHandler) Handle(_ context.Context, r slog.Record) error {
+	icon := map[slog.Level]string{
+		slog.LevelDebug: "🔍", slog.LevelInfo: "💡", slog.LevelWarn: "⚠️ ", slog.LevelError: "❌",
+	}[r.Level]
+	if icon == "" {
+		icon = "•"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "   %s %-5s %-16s", icon, r.Level, r.Message)
+	for _, attr := range h.attrs {
+		writeLessonAttr(&b, "", attr)
+	}
+	prefix := ""
+	if len(h.groups) > 0 {
+		prefix = strings.Join(h.groups, ".") + "."
+	}
+	r.Attrs(func(attr slog.Attr) bool {
+		writeLessonAttr(&b, prefix, attr)
+		return true
+	})
+	b.WriteString("\n")
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	_, err := io.WriteString(h.w, b.String())
+	return err
+}
+
+func (h *lessonHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	clone := *h
+	prefix := ""
+	if len(h.groups) > 0 {
+		prefix = strings.Join(h.groups, ".") + "."
+	}
+	clone.attrs = stdslices.Clone(h.attrs)
+	for _, attr := range attrs {
+		clone.attrs = append(clone.attrs, slog.Attr{Key: prefix + attr.Key, Value: attr.Value})
+	}
+	return &clone
+}
+
+func (h *lessonHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	clone := *h
+	clone.groups = append(stdslices.Clone(h.groups), name)
+	return &clone
+}
+
+func writeLessonAttr(b *strings.Builder, prefix string, attr slog.Attr) {
+	value := attr.Value.Resolve()
+	if value.Kind() == slog.KindGroup {
+		for _, member := range value.Group() {
+			writeLessonAttr(b, prefix+attr.Key+".", member)
+		}
+		return
+	}
+	if attr.Equal(slog.Attr{}) {
+		return
+	}
+	fmt.Fprintf(b, " %s%s=%v", prefix, attr.Key, value)
+}
+
+// Print helper functions
+
+func printLogHeader(title string) {
+	fmt.Println("\n" + strings.Repeat("=", 60))
+	fmt.Printf("  %s\n", title)
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
+
+func printLogSection(title string) {
+	fmt.Printf("┌─ %s\n", title)
+	fmt.Println("│")
+}
+
+func printLogFooter() {
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println("  ✅ Tutorial Complete!")
+	fmt.Println("  💡 Key Takeaways:")
+	fmt.Println("     • slog logs a constant message plus key/value attributes")
+	fmt.Println("     • Text and JSON handlers format the same records")
+	fmt.Println("     • Levels can be changed at runtime with a LevelVar")
+	fmt.Println("     • With and WithGroup build cheap child loggers")
+	fmt.Println("     • LogValuer keeps sensitive fields out of every log")
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"os"
 	"strings"
 )
 
@@ -57,14 +58,45 @@ func printMenu() {
 		"Structs",
 		"Maps",
 		"Defer",
+		"Error Handling",
+		"Panic & Recover",
+		"Runtime Error Gallery",
+		"Pointers",
+		"Strings & Runes",
+		"Iterators",
+		"Slices & Maps Packages",
+		"Embedding & Method Sets",
+		"Packages & Modules",
+		"Testing",
+		"File I/O",
+		"JSON Encoding",
+		"HTTP Servers & Clients",
+		"Concurrency Patterns",
+		"Closures & Loop Vars",
+		"Reflection",
+		"Memory Layout",
+		"Numeric Conversions",
+		"fmt Verbs & Formatting",
+		"Time, Durations & Timers",
+		"Structured Logging",
+		"Runtime & GC",
+		"Profiling with pprof",
+		"Execution Tracing",
+		"Atomics & Lock-Free",
 	}
 
+	// Two columns of 30 characters fill the 60-column frame, so topic
+	// names must stay within 24 characters.
 	for i, topic := range topics {
-		fmt.Printf("  %2d. %-20s", i+1, topic)
-		if (i+1)%2 == 0 {
-			fmt.Println()
+		if i%2 == 0 {
+			fmt.Printf("  %2d. %-24s", i+1, topic)
+		} else {
+			fmt.Printf("  %2d. %s\n", i+1, topic)
 		}
 	}
+	if len(topics)%2 == 1 {
+		fmt.Println()
+	}
 	fmt.Println()
 	fmt.Println(strings.Repeat("─", 60))
 	fmt.Println("  0. Exit Tutorial")
@@ -85,6 +117,23 @@ func getUserChoice() int {
 	return choice
 }
 
+// readLine reads one full line from stdin, a byte at a time so that no
+// input is buffered away from the fmt.Scan calls used by the menu.
+func readLine(prompt string) string {
+	fmt.Print(prompt)
+
+	var line []byte
+	buf := make([]byte, 1)
+	for {
+		n, err := os.Stdin.Read(buf)
+		if n == 0 || err != nil || buf[0] == '\n' {
+			break
+		}
+		line = append(line, buf[0])
+	}
+	return strings.TrimRight(string(line), "\r")
+}
+
 func executeChoice(choice int) {
 	fmt.Println()
 
@@ -113,8 +162,58 @@ func executeChoice(choice int) {
 		maps()
 	case 12:
 		defers()
+	case 13:
+		errorHandling()
+	case 14:
+		panicRecover()
+	case 15:
+		runtimeErrors()
+	case 16:
+		pointers()
+	case 17:
+		stringsAndRunes()
+	case 18:
+		iterators()
+	case 19:
+		collectionPackages()
+	case 20:
+		embedding()
+	case 21:
+		packagesModules()
+	case 22:
+		goTesting()
+	case 23:
+		fileIO()
+	case 24:
+		jsonEncoding()
+	case 25:
+		httpBasics()
+	case 26:
+		concurrencyPatterns()
+	case 27:
+		closures()
+	case 28:
+		reflection()
+	case 29:
+		memoryLayout()
+	case 30:
+		conversions()
+	case 31:
+		formatting()
+	case 32:
+		timeAndDurations()
+	case 33:
+		structuredLogging()
+	case 34:
+		runtimeInsight()
+	case 35:
+		profiling()
+	case 36:
+		tracing()
+	case 37:
+		atomics()
 	default:
-		fmt.Println("❌ Invalid choice! Please enter a number between 0 and 12.")
+		fmt.Println("❌ Invalid choice! Please enter a number between 0 and 37.")
 	}
 }
 
@@ -0,0 +1,347 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"unsafe"
+)
+
+func memoryLayout() {
+	printLayoutHeader("GO MEMORY LAYOUT TUTORIAL")
+
+	// Section 1: Sizes and Alignment of Basic Types
+	printLayoutSection("1. unsafe.Sizeof and unsafe.Alignof")
+	fmt.Printf("   Sizes are for this machine (GOARCH word size %d bytes).\n\n", unsafe.Sizeof(uintptr(0)))
+	fmt.Printf("   ┌──────────────────┬──────────┬───────────┐\n")
+	fmt.Printf("   │ Type             │ Sizeof   │ Alignof   │\n")
+	fmt.Printf("   ├──────────────────┼──────────┼───────────┤\n")
+	for _, row := range []struct {
+		name  string
+		size  uintptr
+		align uintptr
+	}{
+		{"bool", unsafe.Sizeof(false), unsafe.Alignof(false)},
+		{"int8", unsafe.Sizeof(int8(0)), unsafe.Alignof(int8(0))},
+		{"int16", unsafe.Sizeof(int16(0)), unsafe.Alignof(int16(0))},
+		{"int32 / rune", unsafe.Sizeof(int32(0)), unsafe.Alignof(int32(0))},
+		{"int / int64", unsafe.Sizeof(0), unsafe.Alignof(0)},
+		{"float64", unsafe.Sizeof(0.0), unsafe.Alignof(0.0)},
+		{"complex128", unsafe.Sizeof(complex128(0)), unsafe.Alignof(complex128(0))},
+		{"*int", unsafe.Sizeof((*int)(nil)), unsafe.Alignof((*int)(nil))},
+		{"string", unsafe.Sizeof(""), unsafe.Alignof("")},
+		{"[]int", unsafe.Sizeof([]int(nil)), unsafe.Alignof([]int(nil))},
+		{"map[string]int", unsafe.Sizeof(map[string]int(nil)), unsafe.Alignof(map[string]int(nil))},
+		{"any", unsafe.Sizeof(any(nil)), unsafe.Alignof(any(nil))},
+		{"[3]int16", unsafe.Sizeof([3]int16{}), unsafe.Alignof([3]int16{})},
+		{"struct{}", unsafe.Sizeof(struct{}{}), unsafe.Alignof(struct{}{})},
+	} {
+		fmt.Printf("   │ %-16s │ %-8d │ %-9d │\n", row.name, row.size, row.align)
+	}
+	fmt.Printf("   └──────────────────┴──────────┴───────────┘\n")
+	fmt.Printf("   💡 A value of alignment N must start at an address divisible by N\n\n")
+
+	// Section 2: The Lesson Structs
+	printLayoutSection("2. Sizes of the Structs Tutorial Types")
+	var (
+		person   Person
+		employee Employee
+		address  Address
+		rect     Rectangle
+		empty    Empty
+	)
+	fmt.Printf("   ┌───────────┬────────┬─────────┬─────────────┬─────────┐\n")
+	fmt.Printf("   │ Type      │ Sizeof │ Alignof │ Field bytes │ Padding │\n")
+	fmt.Printf("   ├───────────┼────────┼─────────┼─────────────┼─────────┤\n")
+	for _, row := range []struct {
+		name  string
+		size  uintptr
+		align uintptr
+		typ   reflect.Type
+	}{
+		{"Person", unsafe.Sizeof(person), unsafe.Alignof(person), reflect.TypeOf(person)},
+		{"Employee", unsafe.Sizeof(employee), unsafe.Alignof(employee), reflect.TypeOf(employee)},
+		{"Address", unsafe.Sizeof(address), unsafe.Alignof(address), reflect.TypeOf(address)},
+		{"Rectangle", unsafe.Sizeof(rect), unsafe.Alignof(rect), reflect.TypeOf(rect)},
+		{"Empty", unsafe.Sizeof(empty), unsafe.Alignof(empty), reflect.TypeOf(empty)},
+	} {
+		fields := fieldBytes(row.typ)
+		fmt.Printf("   │ %-9s │ %-6d │ %-7d │ %-11d │ %-7d │\n", row.name, row.size, row.align, fields, row.size-fields)
+	}
+	fmt.Printf("   └───────────┴────────┴─────────┴─────────────┴─────────┘\n")
+	fmt.Printf("   unsafe.Offsetof(person.name) = %d\n", unsafe.Offsetof(person.name))
+	fmt.Printf("   unsafe.Offsetof(person.age)  = %d\n", unsafe.Offsetof(person.age))
+	fmt.Printf("   unsafe.Offsetof(person.city) = %d\n", unsafe.Offsetof(person.city))
+	fmt.Printf("   unsafe.Offsetof(employee.address) = %d\n", unsafe.Offsetof(employee.address))
+	fmt.Printf("   💡 Strings and ints are all word-aligned, so these types happen to\n")
+	fmt.Printf("      have no padding - and Empty really is 0 bytes\n\n")
+
+	// Section 3: Field Offset Diagrams
+	printLayoutSection("3. Field Offset Diagrams")
+	fmt.Printf("   One row per 8 bytes; each letter is a byte owned by a field.\n\n")
+	printLayoutDiagram(reflect.TypeOf(person))
+	printLayoutDiagram(reflect.TypeOf(employee))
+	printLayoutDiagram(reflect.TypeOf(rect))
+
+	// Section 4: Padding
+	printLayoutSection("4. Padding Between Fields")
+	fmt.Printf("   type profileLoose struct {\n")
+	fmt.Printf("       active bool\n")
+	fmt.Printf("       age    int64\n")
+	fmt.Printf("       admin  bool\n")
+	fmt.Printf("       id     int32\n")
+	fmt.Printf("       level  uint8\n")
+	fmt.Printf("   }\n\n")
+	printLayoutDiagram(reflect.TypeOf(profileLoose{}))
+	fmt.Printf("   💡 age must start at a multiple of 8, so 7 bytes are wasted after\n")
+	fmt.Printf("      active; the struct is rounded up to a multiple of its alignment\n\n")
+
+	// Section 5: Reordering Fields
+	printLayoutSection("5. Reordering Fields to Shrink a Struct")
+	fmt.Printf("   Sorting fields from largest to smallest alignment packs them:\n\n")
+	fmt.Printf("   type profilePacked struct {\n")
+	fmt.Printf("       age    int64\n")
+	fmt.Printf("       id     int32\n")
+	fmt.Printf("       active bool\n")
+	fmt.Printf("       admin  bool\n")
+	fmt.Printf("       level  uint8\n")
+	fmt.Printf("   }\n\n")
+	printLayoutDiagram(reflect.TypeOf(profilePacked{}))
+	loose, packed := unsafe.Sizeof(profileLoose{}), unsafe.Sizeof(profilePacked{})
+	fmt.Printf("   %d bytes → %d bytes; for a []profile of 1,000,000 that is %.1f MB saved\n\n",
+		loose, packed, float64((loose-packed)*1_000_000)/1e6)
+	fmt.Printf("   Best order for every type in this topic:\n")
+	fmt.Printf("   ┌───────────────┬─────────┬──────────────┐\n")
+	fmt.Printf("   │ Type          │ Current │ Sorted order │\n")
+	fmt.Printf("   ├───────────────┼─────────┼──────────────┤\n")
+	for _, t := range []reflect.Type{
+		reflect.TypeOf(person), reflect.TypeOf(employee), reflect.TypeOf(address),
+		reflect.TypeOf(rect), reflect.TypeOf(profileLoose{}), reflect.TypeOf(profilePacked{}),
+	} {
+		fmt.Printf("   │ %-13s │ %-7d │ %-12d │\n", t.Name(), t.Size(), packedSize(t))
+	}
+	fmt.Printf("   └───────────────┴─────────┴──────────────┘\n")
+	fmt.Printf("   💡 The compiler never reorders fields for you - declaration order\n")
+	fmt.Printf("      is memory order\n\n")
+
+	// Section 6: Zero-Size Types
+	printLayoutSection("6. Zero-Size Types")
+	fmt.Printf("   unsafe.Sizeof(Empty{})                        = %d\n", unsafe.Sizeof(Empty{}))
+	fmt.Printf("   unsafe.Sizeof([0]int{})                       = %d\n", unsafe.Sizeof([0]int{}))
+	fmt.Printf("   unsafe.Sizeof(struct{ _ Empty; n int64 }{})   = %d\n", unsafe.Sizeof(struct {
+		_ Empty
+		n int64
+	}{}))
+	fmt.Printf("   unsafe.Sizeof(struct{ n int64; _ Empty }{})   = %d   ⚠️\n", unsafe.Sizeof(struct {
+		n int64
+		_ Empty
+	}{}))
+	fmt.Printf("   💡 A trailing zero-size field gets padding, so a pointer to it cannot\n")
+	fmt.Printf("      point past the end of the struct - put such fields first\n")
+	fmt.Printf("   💡 map[string]Empty sets and chan Empty signals cost nothing per value\n\n")
+
+	// Section 7: Slice Header
+	printLayoutSection("7. The Slice Header")
+	fmt.Printf("   A slice value is 3 words pointing at a backing array:\n\n")
+	fmt.Printf("   ┌──────────────┬─────────┬─────────┐\n")
+	fmt.Printf("   │ data pointer │ len     │ cap     │   %d bytes\n", unsafe.Sizeof([]int(nil)))
+	fmt.Printf("   └──────┬───────┴─────────┴─────────┘\n")
+	fmt.Printf("          └──▶ [ 0 | 1 | 2 | 3 | 4 | 5 | ... ]\n\n")
+	backing := make([]int, 6, 8)
+	for i := range backing {
+		backing[i] = i
+	}
+	window := backing[2:4]
+	for _, s := range []struct {
+		name  string
+		slice []int
+	}{{"backing", backing}, {"backing[2:4]", window}} {
+		header := (*sliceHeader)(unsafe.Pointer(&s.slice))
+		offset := uintptr(header.data) - uintptr(unsafe.Pointer(unsafe.SliceData(backing)))
+		fmt.Printf("   %-13s data = &backing[0] + %2d bytes, len = %d, cap = %d\n",
+			s.name, offset, header.len, header.cap)
+	}
+	grown := append(window, 40, 50, 60, 70, 80)
+	fmt.Printf("   append(window, 5 more) → shares backing? %t (cap was %d)\n",
+		unsafe.SliceData(grown) == unsafe.SliceData(window), cap(window))
+	fmt.Printf("   💡 Reslicing only moves the pointer and changes len/cap - no copy\n\n")
+
+	// Section 8: String Header
+	printLayoutSection("8. The String Header")
+	fmt.Printf("   ┌──────────────┬─────────┐\n")
+	fmt.Printf("   │ data pointer │ len     │   %d bytes, no cap - strings are immutable\n", unsafe.Sizeof(""))
+	fmt.Printf("   └──────────────┴─────────┘\n\n")
+	greeting := "hello, gopher"
+	word := greeting[7:]
+	copied := string([]byte(greeting))
+	fmt.Printf("   greeting := %q\n", greeting)
+	header := (*stringHeader)(unsafe.Pointer(&greeting))
+	fmt.Printf("   header.len = %d, unsafe.StringData(greeting) == header.data → %t\n",
+		header.len, unsafe.StringData(greeting) == (*byte)(header.data))
+	fmt.Printf("   greeting[7:] data = greeting data + %d bytes (shared)\n",
+		uintptr(unsafe.Pointer(unsafe.StringData(word)))-uintptr(unsafe.Pointer(unsafe.StringData(greeting))))
+	fmt.Printf("   string([]byte(greeting)) shares data? %t (a copy)\n",
+		unsafe.StringData(copied) == unsafe.StringData(greeting))
+	fmt.Printf("   💡 A small substring keeps the whole original string alive -\n")
+	fmt.Printf("      strings.Clone copies it out\n\n")
+
+	// Section 9: Interface Header
+	printLayoutSection("9. The Interface Header")
+	fmt.Printf("   ┌──────────────┬──────────────┐\n")
+	fmt.Printf("   │ type pointer │ data pointer │   %d bytes\n", unsafe.Sizeof(any(nil)))
+	fmt.Printf("   └──────────────┴──────────────┘\n")
+	fmt.Printf("   (non-empty interfaces like Notifier hold an itab pointer - type plus\n")
+	fmt.Printf("   method table - in the first word; still %d bytes)\n\n", unsafe.Sizeof(Notifier(nil)))
+	alice := &Person{name: "Alice", age: 30, city: "New York"}
+	var boxedPointer any = alice
+	var boxedPerson any = *alice
+	var otherPerson any = Person{name: "Bob"}
+	var nothing any
+	pointerHeader := (*ifaceHeader)(unsafe.Pointer(&boxedPointer))
+	personHeader := (*ifaceHeader)(unsafe.Pointer(&boxedPerson))
+	otherHeader := (*ifaceHeader)(unsafe.Pointer(&otherPerson))
+	nothingHeader := (*ifaceHeader)(unsafe.Pointer(&nothing))
+	fmt.Printf("   var boxedPointer any = alice (a *Person)\n")
+	fmt.Printf("   → data pointer == alice itself?          %t\n", pointerHeader.data == unsafe.Pointer(alice))
+	fmt.Printf("   var boxedPerson any = *alice\n")
+	fmt.Printf("   → data pointer == alice?                 %t (the Person was copied)\n", personHeader.data == unsafe.Pointer(alice))
+	fmt.Printf("   → same type pointer as any(Person{Bob})? %t\n", personHeader.typ == otherHeader.typ)
+	fmt.Printf("   var nothing any → type == nil: %t, data == nil: %t\n", nothingHeader.typ == nil, nothingHeader.data == nil)
+	fmt.Printf("   💡 Storing a non-pointer in an interface usually copies it to the\n")
+	fmt.Printf("      heap - see the Pointers tutorial's escape analysis\n\n")
+
+	printLayoutFooter()
+}
+
+// Types for demonstrations
+
+type profileLoose struct {
+	active bool
+	age    int64
+	admin  bool
+	id     int32
+	level  uint8
+}
+
+type profilePacked struct {
+	age    int64
+	id     int32
+	active bool
+	admin  bool
+	level  uint8
+}
+
+// Mirrors of the runtime's own headers. They match the current gc
+// implementation and are only read here, never written.
+
+type sliceHeader struct {
+	data unsafe.Pointer
+	len  int
+	cap  int
+}
+
+type stringHeader struct {
+	data unsafe.Pointer
+	len  int
+}
+
+type ifaceHeader struct {
+	typ  unsafe.Pointer
+	data unsafe.Pointer
+}
+
+// Helper functions for demonstrations
+
+func fieldBytes(t reflect.Type) uintptr {
+	var total uintptr
+	for i := range t.NumField() {
+		total += t.Field(i).Type.Size()
+	}
+	return total
+}
+
+func alignUp(n, align uintptr) uintptr {
+	return (n + align - 1) / align * align
+}
+
+// packedSize is the size t would have with its fields sorted by
+// decreasing alignment.
+func packedSize(t reflect.Type) uintptr {
+	fields := make([]reflect.Type, t.NumField())
+	for i := range fields {
+		fields[i] = t.Field(i).Type
+	}
+	sort.SliceStable(fields, func(i, j int) bool { return fields[i].Align() > fields[j].Align() })
+	var offset uintptr
+	for _, f := range fields {
+		offset = alignUp(offset, uintptr(f.Align())) + f.Size()
+	}
+	return alignUp(offset, uintptr(t.Align()))
+}
+
+// Print helper functions
+
+func printLayoutDiagram(t reflect.Type) {
+	owner := make([]int, t.Size())
+	for i := range owner {
+		owner[i] = -1
+	}
+	for i := range t.NumField() {
+		field := t.Field(i)
+		for b := field.Offset; b < field.Offset+field.Type.Size(); b++ {
+			owner[b] = i
+		}
+	}
+
+	padding := t.Size() - fieldBytes(t)
+	fmt.Printf("   %s: %d bytes, align %d, %d padding\n", t.Name(), t.Size(), t.Align(), padding)
+	fmt.Printf("   offset ┌─────────────────┐\n")
+	for row := 0; row < len(owner); row += 8 {
+		var cells []string
+		for b := row; b < row+8; b++ {
+			switch {
+			case b >= len(owner):
+				cells = append(cells, " ")
+			case owner[b] < 0:
+				cells = append(cells, "·")
+			default:
+				cells = append(cells, string(rune('A'+owner[b])))
+			}
+		}
+		fmt.Printf("   %6d │ %s │\n", row, strings.Join(cells, " "))
+	}
+	fmt.Printf("          └─────────────────┘\n")
+	for i := range t.NumField() {
+		field := t.Field(i)
+		fmt.Printf("   %c = %-8s %-13s offset %2d, size %2d\n",
+			'A'+i, field.Name, field.Type, field.Offset, field.Type.Size())
+	}
+	if padding > 0 {
+		fmt.Printf("   · = padding\n")
+	}
+	fmt.Println()
+}
+
+func printLayoutHeader(title string) {
+	fmt.Println("\n" + strings.Repeat("=", 60))
+	fmt.Printf("  %s\n", title)
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
+
+func printLayoutSection(title string) {
+	fmt.Printf("┌─ %s\n", title)
+	fmt.Println("│")
+}
+
+func printLayoutFooter() {
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println("  ✅ Tutorial Complete!")
+	fmt.Println("  💡 Key Takeaways:")
+	fmt.Println("     • unsafe.Sizeof, Alignof and Offsetof are compile-time facts")
+	fmt.Println("     • Fields are padded to their alignment, in declaration order")
+	fmt.Println("     • Largest-alignment-first ordering minimizes padding")
+	fmt.Println("     • Slices are 3 words, strings and interfaces are 2")
+	fmt.Println("     • Zero-size types are free, except as a trailing field")
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
@@ -0,0 +1,276 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func packagesModules() {
+	printPackageHeader("GO PACKAGES, MODULES AND VISIBILITY TUTORIAL")
+
+	// Section 1: Why a Separate Module
+	printPackageSection("1. Why a Separate Module?")
+	fmt.Printf("   Every lesson in this tutorial lives in one 'package main', so\n")
+	fmt.Printf("   lowercase names are visible everywhere and the PascalCase rule\n")
+	fmt.Printf("   from the Functions tutorial never gets enforced.\n\n")
+	fmt.Printf("   This topic writes a small two-module workspace to a temp\n")
+	fmt.Printf("   directory and runs the local Go toolchain on it (offline):\n\n")
+
+	dir, err := writeScratchModule("packages-demo-", scratchFiles(shopModuleFiles))
+	if err != nil {
+		fmt.Printf("   ❌ could not create scratch module: %v\n\n", err)
+		printPackageFooter()
+		return
+	}
+	defer os.RemoveAll(dir)
+	shopDir := filepath.Join(dir, "shop")
+
+	fmt.Printf("   %s/\n", filepath.Base(dir))
+	printPackageTree(shopModuleFiles)
+	fmt.Println()
+
+	// Section 2: The Module and Its Packages
+	printPackageSection("2. The Module and Its Packages")
+	fmt.Printf("   go.mod names the module; each directory below it is one package,\n")
+	fmt.Printf("   imported by the module path plus the directory.\n\n")
+	for _, file := range shopModuleFiles[:4] {
+		printScratchFile(file)
+	}
+
+	// Section 3: Exported vs Unexported
+	printPackageSection("3. Exported vs Unexported - What Other Packages See")
+	fmt.Printf("   ┌──────────────────┬────────────┬──────────────────────────────┐\n")
+	fmt.Printf("   │ Identifier       │ Visibility │ Usable from                  │\n")
+	fmt.Printf("   ├──────────────────┼────────────┼──────────────────────────────┤\n")
+	fmt.Printf("   │ Store, NewStore  │ Exported   │ any importing package        │\n")
+	fmt.Printf("   │ Store.Name       │ Exported   │ any importing package        │\n")
+	fmt.Printf("   │ Store.items      │ unexported │ package inventory only       │\n")
+	fmt.Printf("   │ normalize        │ unexported │ package inventory only       │\n")
+	fmt.Printf("   └──────────────────┴────────────┴──────────────────────────────┘\n\n")
+	fmt.Printf("   go doc shows the public API - exactly what an importer can use:\n")
+	fmt.Printf("   $ go doc -short ./inventory\n")
+	runPackageTool(shopDir, "doc", "-short", "./inventory")
+	fmt.Printf("   $ go doc -short -u ./inventory   (-u includes unexported)\n")
+	runPackageTool(shopDir, "doc", "-short", "-u", "./inventory")
+
+	// Section 4: Building and init() Order
+	printPackageSection("4. Building and init() Order")
+	fmt.Printf("   $ go build ./...\n")
+	runPackageTool(shopDir, "build", "-o", os.DevNull, "./...")
+	fmt.Printf("   $ go run .\n")
+	runPackageTool(shopDir, "run", ".")
+	fmt.Printf("   💡 Initialization order:\n")
+	fmt.Printf("      1. Imported packages first (pricing → inventory → main)\n")
+	fmt.Printf("      2. Within a package: package-level variables, then init()\n")
+	fmt.Printf("      3. main() runs last, after every init() has finished\n\n")
+
+	// Section 5: Unexported Access Error
+	printPackageSection("5. Deliberate Error - Touching Unexported Names")
+	peekFile := scratchFile{"shop/cmd/peek/main.go", peekSource}
+	peekPath := filepath.Join(dir, filepath.FromSlash(peekFile.path))
+	if err := os.MkdirAll(filepath.Dir(peekPath), 0o755); err != nil {
+		fmt.Printf("   ❌ %v\n\n", err)
+	} else if err := os.WriteFile(peekPath, []byte(peekFile.content), 0o644); err != nil {
+		fmt.Printf("   ❌ %v\n\n", err)
+	}
+	printScratchFile(peekFile)
+	fmt.Printf("   $ go build ./cmd/peek\n")
+	runPackageTool(shopDir, "build", "-o", os.DevNull, "./cmd/peek")
+	fmt.Printf("   💡 Same module, different package - still only exported names\n\n")
+
+	// Section 6: Internal Packages
+	printPackageSection("6. Internal Packages")
+	fmt.Printf("   Code under internal/ can only be imported by code rooted at the\n")
+	fmt.Printf("   parent of internal/ - here, anything inside example.com/shop.\n\n")
+	fmt.Printf("   inventory → shop/internal/pricing   ✅ allowed (same tree)\n\n")
+	fmt.Printf("   A separate module that points at shop with a replace directive:\n\n")
+	for _, file := range shopModuleFiles[4:] {
+		printScratchFile(file)
+	}
+	fmt.Printf("   $ cd client && go build .\n")
+	runPackageTool(filepath.Join(dir, "client"), "build", "-o", os.DevNull, ".")
+	fmt.Printf("   💡 internal/ lets a module share code between its own packages\n")
+	fmt.Printf("      without making it part of the public API\n\n")
+
+	// Section 7: Summary
+	printPackageSection("7. Visibility Rules at a Glance")
+	fmt.Printf("   ┌─────────────────────────┬──────────────────────────────────┐\n")
+	fmt.Printf("   │ Rule                    │ Enforced by                      │\n")
+	fmt.Printf("   ├─────────────────────────┼──────────────────────────────────┤\n")
+	fmt.Printf("   │ Uppercase first letter  │ compiler - per package           │\n")
+	fmt.Printf("   │ internal/ directories   │ go command - per import path     │\n")
+	fmt.Printf("   │ module path in go.mod   │ go command - import resolution   │\n")
+	fmt.Printf("   │ init() order            │ runtime - dependencies first     │\n")
+	fmt.Printf("   └─────────────────────────┴──────────────────────────────────┘\n\n")
+
+	printPackageFooter()
+}
+
+// Files for the scratch workspace
+
+var shopModuleFiles = []scratchFile{
+	{"shop/go.mod", "module example.com/shop\n\ngo 1.25\n"},
+	{"shop/internal/pricing/pricing.go", `package pricing
+
+import "fmt"
+
+var prices = loadPrices()
+
+func loadPrices() map[string]float64 {
+	fmt.Println("pricing: package variables initialized")
+	return map[string]float64{"apple": 0.5, "pear": 0.75}
+}
+
+func init() {
+	fmt.Println("pricing: init()")
+}
+
+// Price is exported, but internal/ limits who can import this package.
+func Price(item string) float64 {
+	return prices[item]
+}
+`},
+	{"shop/inventory/inventory.go", `package inventory
+
+import (
+	"fmt"
+	"strings"
+
+	"example.com/shop/internal/pricing"
+)
+
+// Store is exported; its items field is not.
+type Store struct {
+	Name  string
+	items map[string]int
+}
+
+func init() {
+	fmt.Println("inventory: init()")
+}
+
+// NewStore is the only way other packages can get a ready Store.
+func NewStore(name string) *Store {
+	return &Store{Name: name, items: map[string]int{}}
+}
+
+func (s *Store) Add(item string, qty int) {
+	s.items[normalize(item)] += qty
+}
+
+func (s *Store) Value() float64 {
+	total := 0.0
+	for item, qty := range s.items {
+		total += pricing.Price(item) * float64(qty)
+	}
+	return total
+}
+
+func normalize(item string) string {
+	return strings.ToLower(strings.TrimSpace(item))
+}
+`},
+	{"shop/main.go", `package main
+
+import (
+	"fmt"
+
+	"example.com/shop/inventory"
+)
+
+func init() {
+	fmt.Println("main: init()")
+}
+
+func main() {
+	fmt.Println("main: main()")
+	store := inventory.NewStore("Corner Shop")
+	store.Add(" Apple ", 4)
+	store.Add("pear", 2)
+	fmt.Printf("%s stock value: $%.2f\n", store.Name, store.Value())
+}
+`},
+	{"client/go.mod", `module example.com/client
+
+go 1.25
+
+require example.com/shop v0.0.0
+
+replace example.com/shop => ../shop
+`},
+	{"client/main.go", `package main
+
+import (
+	"fmt"
+
+	"example.com/shop/internal/pricing"
+)
+
+func main() {
+	fmt.Println(pricing.Price("apple"))
+}
+`},
+}
+
+const peekSource = `package main
+
+import (
+	"fmt"
+
+	"example.com/shop/inventory"
+)
+
+func main() {
+	store := inventory.NewStore("Peek")
+	fmt.Println(store.items)
+	fmt.Println(inventory.normalize(" Pear "))
+}
+`
+
+func runPackageTool(dir string, args ...string) {
+	output, err := runGoTool(dir, args...)
+	printToolOutput(output)
+	if err != nil {
+		fmt.Printf("   ❌ %v\n", err)
+	} else {
+		fmt.Printf("   ✅ ok\n")
+	}
+	fmt.Println()
+}
+
+// Print helper functions
+
+func printPackageTree(files []scratchFile) {
+	for i, file := range files {
+		branch := "├──"
+		if i == len(files)-1 {
+			branch = "└──"
+		}
+		fmt.Printf("   %s %s\n", branch, file.path)
+	}
+}
+
+func printPackageHeader(title string) {
+	fmt.Println("\n" + strings.Repeat("=", 60))
+	fmt.Printf("  %s\n", title)
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
+
+func printPackageSection(title string) {
+	fmt.Printf("┌─ %s\n", title)
+	fmt.Println("│")
+}
+
+func printPackageFooter() {
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println("  ✅ Tutorial Complete!")
+	fmt.Println("  💡 Key Takeaways:")
+	fmt.Println("     • A module is a tree of packages named by go.mod")
+	fmt.Println("     • Uppercase names are exported; lowercase stay in the package")
+	fmt.Println("     • internal/ restricts imports to the parent's subtree")
+	fmt.Println("     • Dependencies initialize first: variables, then init()")
+	fmt.Println("     • go doc shows exactly the API other packages can use")
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
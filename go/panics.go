@@ -0,0 +1,346 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"strings"
+)
+
+func panicRecover() {
+	printPanicHeader("GO PANIC AND RECOVER TUTORIAL")
+
+	// Section 1: What is panic?
+	printPanicSection("1. What is panic?")
+	fmt.Printf("   panic stops the normal flow of a goroutine:\n")
+	fmt.Printf("   ✅ The current function stops immediately\n")
+	fmt.Printf("   ✅ Deferred calls run while the stack unwinds\n")
+	fmt.Printf("   ✅ If nothing recovers, the program crashes with a stack trace\n\n")
+	fmt.Printf("   💡 Every demo below runs inside runProtected(), which recovers\n")
+	fmt.Printf("      the panic so the tutorial keeps running.\n\n")
+
+	// Section 2: The Protected Runner
+	printPanicSection("2. The Protected Runner")
+	fmt.Printf("   func capturePanic(fn func()) (recovered any, frames []string) {\n")
+	fmt.Printf("       defer func() {\n")
+	fmt.Printf("           if r := recover(); r != nil {\n")
+	fmt.Printf("               recovered = r\n")
+	fmt.Printf("               frames = simplifyStack(debug.Stack())   // drops runtime frames\n")
+	fmt.Printf("           }\n")
+	fmt.Printf("       }()\n")
+	fmt.Printf("       fn()\n")
+	fmt.Printf("       return nil, nil\n")
+	fmt.Printf("   }\n\n")
+	fmt.Printf("   runProtected(name, fn) calls capturePanic(fn) and prints what it returns.\n\n")
+	runProtected("panic(\"something went wrong\")", func() {
+		panic("something went wrong")
+	})
+
+	// Section 3: Deferred Calls Run During Unwinding
+	printPanicSection("3. Deferred Calls Run During Unwinding")
+	fmt.Printf("   func panicExample() {\n")
+	fmt.Printf("       defer fmt.Println(\"Cleanup runs even on panic!\")\n")
+	fmt.Printf("       panic(\"Something went wrong\")\n")
+	fmt.Printf("   }\n\n")
+	runProtected("panicExample()", panicExample)
+
+	fmt.Printf("   Panics unwind through every frame, running each frame's defers:\n\n")
+	fmt.Printf("   outerFrame() → middleFrame() → innerFrame() → panic\n\n")
+	runProtected("outerFrame()", outerFrame)
+
+	// Section 4: recover() Only Works Inside a Deferred Function
+	printPanicSection("4. recover() Only Works Inside a Deferred Function")
+	fmt.Printf("   Called normally (no panic in progress):\n")
+	fmt.Printf("   r := recover()\n")
+	fmt.Printf("   → %v\n\n", recover())
+
+	fmt.Printf("   Called before the panic, not deferred:\n")
+	fmt.Printf("   func recoverTooEarly() {\n")
+	fmt.Printf("       recover()  // nothing to recover yet\n")
+	fmt.Printf("       panic(\"too early\")\n")
+	fmt.Printf("   }\n\n")
+	runProtected("recoverTooEarly()", recoverTooEarly)
+
+	fmt.Printf("   Called from a helper inside the deferred function:\n")
+	fmt.Printf("   func recoverFromHelper() {\n")
+	fmt.Printf("       defer func() { helperRecover() }()  // NOT called directly\n")
+	fmt.Printf("       panic(\"helper can't catch me\")\n")
+	fmt.Printf("   }\n\n")
+	runProtected("recoverFromHelper()", recoverFromHelper)
+
+	fmt.Printf("   Called directly by the deferred function:\n")
+	fmt.Printf("   func recoverDirectly() {\n")
+	fmt.Printf("       defer func() {\n")
+	fmt.Printf("           if r := recover(); r != nil {\n")
+	fmt.Printf("               fmt.Println(\"caught:\", r)\n")
+	fmt.Printf("           }\n")
+	fmt.Printf("       }()\n")
+	fmt.Printf("       panic(\"caught locally\")\n")
+	fmt.Printf("   }\n\n")
+	runProtected("recoverDirectly()", recoverDirectly)
+
+	// Section 5: Re-panicking
+	printPanicSection("5. Re-panicking (Recover, Inspect, Panic Again)")
+	fmt.Printf("   Recover only what you understand; re-panic everything else:\n\n")
+	fmt.Printf("   defer func() {\n")
+	fmt.Printf("       r := recover()\n")
+	fmt.Printf("       if r == errRetryable {\n")
+	fmt.Printf("           return  // handled\n")
+	fmt.Printf("       }\n")
+	fmt.Printf("       panic(r)  // not ours - keep unwinding\n")
+	fmt.Printf("   }()\n\n")
+	runProtected("selectiveRecover(errRetryable)", func() { selectiveRecover(errRetryable) })
+	runProtected("selectiveRecover(\"corrupted state\")", func() { selectiveRecover("corrupted state") })
+
+	// Section 6: Converting Panics to Errors at API Boundaries
+	printPanicSection("6. Converting Panics to Errors at API Boundaries")
+	fmt.Printf("   func safeCall(fn func()) (err error) {\n")
+	fmt.Printf("       defer func() {\n")
+	fmt.Printf("           if r := recover(); r != nil {\n")
+	fmt.Printf("               if rErr, ok := r.(error); ok {\n")
+	fmt.Printf("                   err = fmt.Errorf(\"recovered panic: %%w\", rErr)   // keeps errors.As working\n")
+	fmt.Printf("               } else {\n")
+	fmt.Printf("                   err = fmt.Errorf(\"recovered panic: %%v\", r)\n")
+	fmt.Printf("               }\n")
+	fmt.Printf("           }\n")
+	fmt.Printf("       }()\n")
+	fmt.Printf("       fn()\n")
+	fmt.Printf("       return nil\n")
+	fmt.Printf("   }\n\n")
+
+	for _, input := range []string{"42", "-7", "abc"} {
+		fmt.Printf("   parseAge(%q)\n", input)
+		age, err := parseAge(input)
+		if err != nil {
+			fmt.Printf("   → error: %v\n", err)
+			var runtimeErr runtime.Error
+			fmt.Printf("   → errors.As(err, &runtime.Error): %t\n\n", errors.As(err, &runtimeErr))
+		} else {
+			fmt.Printf("   → age: %d\n\n", age)
+		}
+	}
+	fmt.Printf("   💡 Callers get an ordinary error - the panic never leaks out\n\n")
+
+	// Section 7: Recovering Runtime Errors
+	printPanicSection("7. Recovering Runtime Errors")
+	fmt.Printf("   Runtime failures panic with a value implementing runtime.Error:\n\n")
+	fmt.Printf("   var scores []int\n")
+	fmt.Printf("   _ = scores[3]\n\n")
+	runProtected("index out of range", func() {
+		var scores []int
+		index := 3
+		_ = scores[index]
+	})
+
+	// Section 8: panic(nil)
+	printPanicSection("8. panic(nil) Since Go 1.21")
+	fmt.Printf("   recover() used to return nil for panic(nil), hiding the panic.\n")
+	fmt.Printf("   Now it returns a *runtime.PanicNilError instead:\n\n")
+	runProtected("panic(nil)", func() {
+		panic(nil)
+	})
+
+	// Section 9: When to Use panic
+	printPanicSection("9. When to Use panic")
+	fmt.Printf("   ┌──────────────────────────────┬──────────────────────────────┐\n")
+	fmt.Printf("   │ Use panic                    │ Use error                    │\n")
+	fmt.Printf("   ├──────────────────────────────┼──────────────────────────────┤\n")
+	fmt.Printf("   │ Programmer bugs (impossible) │ Expected failures            │\n")
+	fmt.Printf("   │ Broken invariants            │ Bad user input               │\n")
+	fmt.Printf("   │ Failed init (regexp.Must...) │ Files, network, parsing      │\n")
+	fmt.Printf("   └──────────────────────────────┴──────────────────────────────┘\n\n")
+	fmt.Printf("   ⚠️  Never let a panic cross a package API - convert it to an error\n\n")
+
+	printPanicFooter()
+}
+
+// Protected runner and stack helpers
+
+func runProtected(name string, fn func()) {
+	fmt.Printf("   ▶ running %s\n", name)
+	r, frames := capturePanic(fn)
+	if r != nil {
+		fmt.Printf("   🛟 recovered: %v (type: %T)\n", r, r)
+		fmt.Printf("   Stack (simplified):\n")
+		for _, frame := range frames {
+			fmt.Printf("      %s\n", frame)
+		}
+	} else {
+		fmt.Printf("   ✅ returned normally\n")
+	}
+	fmt.Println()
+}
+
+func capturePanic(fn func()) (recovered any, frames []string) {
+	defer func() {
+		if r := recover(); r != nil {
+			recovered = r
+			frames = simplifyStack(debug.Stack())
+		}
+	}()
+	fn()
+	return nil, nil
+}
+
+func simplifyStack(stack []byte) []string {
+	lines := strings.Split(strings.TrimSpace(string(stack)), "\n")
+
+	// Skip everything up to the panic call itself, so the trace starts
+	// at the frame that actually panicked.
+	start := 1
+	for i, line := range lines {
+		if strings.HasPrefix(line, "panic(") {
+			start = i + 2
+			break
+		}
+	}
+
+	var frames []string
+	for i := start; i+1 < len(lines); i += 2 {
+		function := lines[i]
+		if paren := strings.LastIndex(function, "("); paren > 0 {
+			function = function[:paren]
+		}
+		if strings.HasPrefix(function, "runtime.") || function == "panic" {
+			continue
+		}
+
+		location := strings.TrimSpace(lines[i+1])
+		if space := strings.Index(location, " "); space > 0 {
+			location = location[:space]
+		}
+		frames = append(frames, fmt.Sprintf("%-34s %s", function, filepath.Base(location)))
+
+		if function == "main.capturePanic" || function == "main.main" {
+			break
+		}
+	}
+	return frames
+}
+
+// Example functions
+
+var errRetryable = errors.New("temporary failure, please retry")
+
+func panicExample() {
+	defer fmt.Println("   Cleanup runs even on panic!")
+	panic("Something went wrong")
+}
+
+func outerFrame() {
+	defer fmt.Println("   ↩ outerFrame defer")
+	middleFrame()
+	fmt.Println("   never printed")
+}
+
+func middleFrame() {
+	defer fmt.Println("   ↩ middleFrame defer")
+	innerFrame()
+	fmt.Println("   never printed")
+}
+
+func innerFrame() {
+	defer fmt.Println("   ↩ innerFrame defer")
+	panic("boom from innerFrame")
+}
+
+func recoverTooEarly() {
+	r := recover()
+	fmt.Printf("   recover() before panic → %v\n", r)
+	panic("too early")
+}
+
+func helperRecover() {
+	r := recover()
+	fmt.Printf("   recover() in helper → %v (not called directly by the deferred func)\n", r)
+}
+
+func recoverFromHelper() {
+	defer func() { helperRecover() }()
+	panic("helper can't catch me")
+}
+
+func recoverDirectly() {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Printf("   caught: %v\n", r)
+		}
+	}()
+	panic("caught locally")
+}
+
+func selectiveRecover(value any) {
+	defer func() {
+		r := recover()
+		if r == errRetryable {
+			fmt.Printf("   handled %q locally\n", r.(error).Error())
+			return
+		}
+		fmt.Printf("   don't know how to handle %v - re-panicking\n", r)
+		panic(r)
+	}()
+	panic(value)
+}
+
+func safeCall(fn func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if rErr, ok := r.(error); ok {
+				err = fmt.Errorf("recovered panic: %w", rErr)
+			} else {
+				err = fmt.Errorf("recovered panic: %v", r)
+			}
+		}
+	}()
+	fn()
+	return nil
+}
+
+func parseAge(input string) (age int, err error) {
+	err = safeCall(func() {
+		age = mustParseAge(input)
+	})
+	return age, err
+}
+
+func mustParseAge(input string) int {
+	ages := []int{0}
+	n, err := strconv.Atoi(input)
+	if err != nil {
+		panic(fmt.Sprintf("invalid age %q", input))
+	}
+	if n < 0 {
+		// Deliberate bug: a negative age indexes past the end of the slice
+		return ages[-n]
+	}
+	return n
+}
+
+// Print helper functions
+
+func printPanicHeader(title string) {
+	fmt.Println("\n" + strings.Repeat("=", 60))
+	fmt.Printf("  %s\n", title)
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
+
+func printPanicSection(title string) {
+	fmt.Printf("┌─ %s\n", title)
+	fmt.Println("│")
+}
+
+func printPanicFooter() {
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println("  ✅ Tutorial Complete!")
+	fmt.Println("  💡 Key Takeaways:")
+	fmt.Println("     • panic unwinds the stack, running deferred calls")
+	fmt.Println("     • recover() only works when called directly by a deferred func")
+	fmt.Println("     • recover() returns nil when no panic is in progress")
+	fmt.Println("     • Re-panic values you don't know how to handle")
+	fmt.Println("     • Convert panics to errors at API boundaries")
+	fmt.Println("     • Prefer errors for expected failures")
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
@@ -0,0 +1,349 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+func pointers() {
+	printPointerHeader("GO POINTERS TUTORIAL")
+
+	// Section 1: What is a Pointer?
+	printPointerSection("1. What is a Pointer?")
+	fmt.Printf("   A pointer holds the memory ADDRESS of a value.\n")
+	fmt.Printf("   ✅ &x gives the address of x\n")
+	fmt.Printf("   ✅ *p reads or writes the value at that address\n")
+	fmt.Printf("   ✅ The zero value of a pointer is nil\n")
+	fmt.Printf("   ✅ No pointer arithmetic - Go keeps pointers safe\n\n")
+
+	// Section 2: & and *
+	printPointerSection("2. The & (Address-Of) and * (Dereference) Operators")
+	x := 42
+	p := &x
+	fmt.Printf("   x := 42\n")
+	fmt.Printf("   p := &x\n\n")
+	fmt.Printf("   &x = %p\n", &x)
+	fmt.Printf("   p  = %p (type: %T)\n", p, p)
+	fmt.Printf("   *p = %d\n\n", *p)
+
+	fmt.Printf("   *p = 100  // write through the pointer\n")
+	*p = 100
+	fmt.Printf("   → x = %d (x changed - p points at x's memory)\n\n", x)
+
+	// Section 3: Copies vs Shared Memory
+	printPointerSection("3. Copies vs Shared Memory")
+	a := 10
+	b := a
+	pa1 := &a
+	pa2 := &a
+	fmt.Printf("   a := 10\n")
+	fmt.Printf("   b := a    // copy\n")
+	fmt.Printf("   pa1, pa2 := &a, &a\n\n")
+	fmt.Printf("   &a  = %p\n", &a)
+	fmt.Printf("   &b  = %p\n", &b)
+	fmt.Printf("   pa1 = %p\n", pa1)
+	fmt.Printf("   pa2 = %p\n\n", pa2)
+	fmt.Printf("   &a == &b   → %t (b is a separate copy)\n", &a == &b)
+	fmt.Printf("   pa1 == pa2 → %t %s\n\n", pa1 == pa2, sharesMemory(pa1 == pa2))
+
+	// Section 4: new vs make
+	printPointerSection("4. new vs make")
+	fmt.Printf("   ┌────────────┬───────────────────────────┬──────────────────────┐\n")
+	fmt.Printf("   │ Built-in   │ Works with                │ Returns              │\n")
+	fmt.Printf("   ├────────────┼───────────────────────────┼──────────────────────┤\n")
+	fmt.Printf("   │ new(T)     │ Any type                  │ *T pointing at zero  │\n")
+	fmt.Printf("   │ make(T, …) │ Slices, maps and channels │ Ready-to-use T value │\n")
+	fmt.Printf("   └────────────┴───────────────────────────┴──────────────────────┘\n\n")
+
+	count := new(int)
+	fmt.Printf("   count := new(int)\n")
+	fmt.Printf("   → count = %p, *count = %d (type: %T)\n\n", count, *count, count)
+
+	person := new(Person)
+	person.name = "Alice"
+	fmt.Printf("   person := new(Person)  // same as &Person{}\n")
+	fmt.Printf("   person.name = \"Alice\"\n")
+	fmt.Printf("   → %+v (type: %T)\n\n", *person, person)
+
+	scores := make(map[string]int)
+	scores["Math"] = 95
+	fmt.Printf("   scores := make(map[string]int)\n")
+	fmt.Printf("   → %v (type: %T) - usable immediately\n\n", scores, scores)
+
+	mapPtr := new(map[string]int)
+	fmt.Printf("   mapPtr := new(map[string]int)\n")
+	fmt.Printf("   → *mapPtr == nil: %t ⚠️  a pointer to a nil map - writes would panic\n\n", *mapPtr == nil)
+
+	// Section 5: nil Pointers
+	printPointerSection("5. nil Pointers")
+	var np *Person
+	fmt.Printf("   var np *Person\n")
+	fmt.Printf("   → np == nil: %t, np = %v\n\n", np == nil, np)
+	fmt.Printf("   Reading np.name without a check:\n")
+	r, _ := capturePanic(func() { fmt.Println(np.name) })
+	fmt.Printf("   💥 panic: %v\n\n", r)
+	fmt.Printf("   Safe version:\n")
+	fmt.Printf("   if np != nil {\n")
+	fmt.Printf("       fmt.Println(np.name)\n")
+	fmt.Printf("   }\n")
+	fmt.Printf("   💡 See the Runtime Error Gallery for the full crash output\n\n")
+
+	// Section 6: Pointer to Array vs Slice
+	printPointerSection("6. Pointer to Array vs Slice")
+	arr := [3]int{1, 2, 3}
+	arrPtr := &arr
+	arrCopy := arr
+	arrSlice := arr[:]
+	fmt.Printf("   arr := [3]int{1, 2, 3}\n")
+	fmt.Printf("   arrPtr := &arr      // *[3]int\n")
+	fmt.Printf("   arrCopy := arr      // arrays are values - full copy\n")
+	fmt.Printf("   arrSlice := arr[:]  // slice header pointing into arr\n\n")
+	fmt.Printf("   &arr[0]      = %p\n", &arr[0])
+	fmt.Printf("   &arrPtr[0]   = %p %s\n", &arrPtr[0], sharesMemory(&arrPtr[0] == &arr[0]))
+	fmt.Printf("   &arrSlice[0] = %p %s\n", &arrSlice[0], sharesMemory(&arrSlice[0] == &arr[0]))
+	fmt.Printf("   &arrCopy[0]  = %p %s\n\n", &arrCopy[0], sharesMemory(&arrCopy[0] == &arr[0]))
+
+	arrPtr[0] = 100
+	arrSlice[1] = 200
+	arrCopy[2] = 300
+	fmt.Printf("   arrPtr[0] = 100    // auto-dereferenced, like (*arrPtr)[0]\n")
+	fmt.Printf("   arrSlice[1] = 200\n")
+	fmt.Printf("   arrCopy[2] = 300\n")
+	fmt.Printf("   → arr     = %v\n", arr)
+	fmt.Printf("   → arrCopy = %v\n\n", arrCopy)
+
+	fmt.Printf("   Passing to functions:\n")
+	fmt.Printf("   func zeroArray(a [3]int)      { a[0] = 0 }   // gets a copy\n")
+	fmt.Printf("   func zeroArrayPtr(a *[3]int)  { a[0] = 0 }   // gets the address\n")
+	fmt.Printf("   func zeroSlice(s []int)       { s[0] = 0 }   // header copy, same array\n\n")
+	zeroArray(arr)
+	fmt.Printf("   zeroArray(arr)     → arr = %v\n", arr)
+	zeroSlice(arr[1:])
+	fmt.Printf("   zeroSlice(arr[1:]) → arr = %v\n", arr)
+	zeroArrayPtr(&arr)
+	fmt.Printf("   zeroArrayPtr(&arr) → arr = %v\n\n", arr)
+
+	// Section 7: Pointer Receivers
+	printPointerSection("7. Value vs Pointer Receivers")
+	fmt.Printf("   func (c counter) incrementValue()    { c.n++ }  // works on a copy\n")
+	fmt.Printf("   func (c *counter) incrementPointer() { c.n++ }  // works on the original\n\n")
+	c := counter{}
+	fmt.Printf("   c := counter{}\n")
+	fmt.Printf("   &c                    = %p\n", &c)
+	fmt.Printf("   receiver in value     = %p %s\n", c.valueReceiverAddress(), sharesMemory(c.valueReceiverAddress() == &c))
+	fmt.Printf("   receiver in pointer   = %p %s\n\n", c.pointerReceiverAddress(), sharesMemory(c.pointerReceiverAddress() == &c))
+
+	c.incrementValue()
+	fmt.Printf("   c.incrementValue()   → c.n = %d (copy was incremented)\n", c.n)
+	c.incrementPointer()
+	fmt.Printf("   c.incrementPointer() → c.n = %d (Go rewrote it as (&c).incrementPointer())\n\n", c.n)
+
+	fmt.Printf("   Same idea from the Structs tutorial:\n")
+	henry := Person{name: "Henry", age: 45, city: "Austin"}
+	fmt.Printf("   henry.haveBirthday()      // func (p *Person) haveBirthday()\n")
+	henry.haveBirthday()
+	fmt.Printf("   → henry.age = %d\n", henry.age)
+	fmt.Printf("   updateAge(&henry, 50)     // func updateAge(p *Person, newAge int)\n")
+	updateAge(&henry, 50)
+	fmt.Printf("   → henry.age = %d\n\n", henry.age)
+
+	// Section 8: Pointers to Struct Fields
+	printPointerSection("8. Pointers to Struct Fields")
+	emp := Employee{name: "Eve", age: 28, address: Address{city: "Seattle"}}
+	cityPtr := &emp.address.city
+	fmt.Printf("   emp := Employee{name: \"Eve\", age: 28, address: Address{city: \"Seattle\"}}\n")
+	fmt.Printf("   cityPtr := &emp.address.city\n\n")
+	fmt.Printf("   &emp              = %p\n", &emp)
+	fmt.Printf("   &emp.name         = %p (first field - same address as the struct)\n", &emp.name)
+	fmt.Printf("   &emp.address.city = %p\n\n", cityPtr)
+	*cityPtr = "Portland"
+	fmt.Printf("   *cityPtr = \"Portland\"\n")
+	fmt.Printf("   → emp.address.city = %q\n\n", emp.address.city)
+
+	// Section 9: Escape Analysis (optional)
+	printPointerSection("9. Escape Analysis - Stack or Heap? (Optional)")
+	fmt.Printf("   The compiler decides where each value lives:\n")
+	fmt.Printf("   • Stack - freed automatically when the function returns\n")
+	fmt.Printf("   • Heap  - needed when a value outlives its function (GC cleans up)\n\n")
+	fmt.Printf("   'go build -gcflags=-m' prints those decisions.\n")
+	answer := readLine("   👉 Run escape analysis on the demo source now? (y/N): ")
+	if strings.EqualFold(strings.TrimSpace(answer), "y") {
+		fmt.Println()
+		runEscapeAnalysis()
+	} else {
+		fmt.Printf("   Skipped.\n\n")
+	}
+
+	printPointerFooter()
+}
+
+// Types and helpers for demonstrations
+
+type counter struct {
+	n int
+}
+
+func (c counter) incrementValue() {
+	c.n++
+}
+
+func (c *counter) incrementPointer() {
+	c.n++
+}
+
+func (c counter) valueReceiverAddress() *counter {
+	return &c
+}
+
+func (c *counter) pointerReceiverAddress() *counter {
+	return c
+}
+
+func zeroArray(a [3]int) {
+	a[0] = 0
+}
+
+func zeroArrayPtr(a *[3]int) {
+	a[0] = 0
+}
+
+func zeroSlice(s []int) {
+	s[0] = 0
+}
+
+func sharesMemory(same bool) string {
+	if same {
+		return "✅ same memory"
+	}
+	return "❌ different memory"
+}
+
+// Escape analysis pane
+
+const escapeDemoSource = `package main
+
+type Person struct {
+	name string
+	age  int
+}
+
+func stackOnly() int {
+	x := 42
+	p := &x
+	return *p
+}
+
+func returnsPointer() *int {
+	x := 42
+	return &x
+}
+
+func newPerson(name string) *Person {
+	return &Person{name: name, age: 30}
+}
+
+func newPersonValue(name string) Person {
+	return Person{name: name, age: 30}
+}
+
+func (p *Person) haveBirthday() {
+	p.age++
+}
+
+func fixedSizeSlice() int {
+	s := make([]int, 8)
+	return len(s)
+}
+
+func dynamicSizeSlice(n int) int {
+	s := make([]int, n)
+	return len(s)
+}
+
+func boxedInInterface() any {
+	x := 7
+	return x
+}
+
+func main() {
+	_ = stackOnly()
+	_ = returnsPointer()
+	_ = newPerson("Alice")
+	_ = newPersonValue("Bob")
+	p := Person{name: "Carol"}
+	p.haveBirthday()
+	_ = fixedSizeSlice()
+	_ = dynamicSizeSlice(100)
+	_ = boxedInInterface()
+}
+`
+
+func runEscapeAnalysis() {
+	dir, err := writeScratchModule("escape-demo-", map[string]string{
+		"go.mod":  "module escapedemo\n\ngo 1.25\n",
+		"main.go": escapeDemoSource,
+	})
+	if err != nil {
+		fmt.Printf("   ❌ could not create scratch module: %v\n\n", err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	fmt.Printf("   Demo source (main.go):\n")
+	for i, line := range strings.Split(strings.TrimRight(escapeDemoSource, "\n"), "\n") {
+		fmt.Printf("   %3d │ %s\n", i+1, strings.ReplaceAll(line, "\t", "    "))
+	}
+	fmt.Println()
+
+	// -l disables inlining so each decision is reported in its own function
+	fmt.Printf("   $ go build -gcflags='-m -l' .\n")
+	output, err := runGoTool(dir, "build", "-gcflags=-m -l", "-o", os.DevNull, ".")
+	if err != nil && !strings.Contains(output, "escape") {
+		fmt.Printf("   ❌ %v\n", err)
+		printToolOutput(output)
+		fmt.Println()
+		return
+	}
+	for _, line := range strings.Split(output, "\n") {
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		marker := "  "
+		switch {
+		case strings.Contains(line, "moved to heap"), strings.Contains(line, "escapes to heap"):
+			marker = "🔺"
+		case strings.Contains(line, "does not escape"):
+			marker = "🟢"
+		}
+		fmt.Printf("   %s %s\n", marker, strings.TrimPrefix(line, "./"))
+	}
+	fmt.Printf("\n   🔺 heap allocation   🟢 stays on the stack\n")
+	fmt.Printf("   💡 Returning &x is safe in Go - the compiler moves x to the heap\n\n")
+}
+
+// Print helper functions
+
+func printPointerHeader(title string) {
+	fmt.Println("\n" + strings.Repeat("=", 60))
+	fmt.Printf("  %s\n", title)
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
+
+func printPointerSection(title string) {
+	fmt.Printf("┌─ %s\n", title)
+	fmt.Println("│")
+}
+
+func printPointerFooter() {
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println("  ✅ Tutorial Complete!")
+	fmt.Println("  💡 Key Takeaways:")
+	fmt.Println("     • &x takes an address, *p follows it")
+	fmt.Println("     • Two pointers are equal when they share the same memory")
+	fmt.Println("     • new(T) returns *T; make() builds slices, maps and channels")
+	fmt.Println("     • Arrays copy on assignment; slices share their backing array")
+	fmt.Println("     • Pointer receivers modify the original value")
+	fmt.Println("     • The compiler moves values to the heap when they escape")
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
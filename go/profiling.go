@@ -0,0 +1,617 @@
+package main
+
+import (
+	"cmp"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	stdslices "slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	profileFibN        = 35
+	profileReportLines = 8000
+)
+
+func profiling() {
+	printProfHeader("GO PROFILING WITH PPROF TUTORIAL")
+
+	// Section 1: Profiling In-Process
+	printProfSection("1. Profiling In-Process")
+	fmt.Printf("   runtime/pprof records profiles from inside the running program:\n\n")
+	fmt.Printf("   f, err := os.Create(filepath.Join(dir, \"cpu.pprof\"))\n")
+	fmt.Printf("   pprof.StartCPUProfile(f)   // samples every goroutine's stack ~100×/s\n")
+	fmt.Printf("   slowWorkload()\n")
+	fmt.Printf("   pprof.StopCPUProfile()     // flushes the profile to f\n\n")
+	dir, err := os.MkdirTemp("", "pprof-demo-")
+	if err != nil {
+		fmt.Printf("   ❌ could not create temp dir: %v\n\n", err)
+		printProfFooter()
+		return
+	}
+	defer os.RemoveAll(dir)
+	fmt.Printf("   Profiles are written to %s (removed at the end)\n\n", dir)
+
+	// Section 2: Two Slow Workloads
+	printProfSection("2. Two Deliberately Slow Workloads")
+	fmt.Printf("   func slowFib(n int) int {          // exponential: recomputes\n")
+	fmt.Printf("       if n < 2 {                     // the same values again\n")
+	fmt.Printf("           return n                   // and again\n")
+	fmt.Printf("       }\n")
+	fmt.Printf("       return slowFib(n-1) + slowFib(n-2)\n")
+	fmt.Printf("   }\n\n")
+	fmt.Printf("   func concatReport(n int) string {\n")
+	fmt.Printf("       report := \"\"\n")
+	fmt.Printf("       for i := range n {\n")
+	fmt.Printf("           report += \"line \" + strconv.Itoa(i) + \": ok\\n\"   // copies it all\n")
+	fmt.Printf("       }\n")
+	fmt.Printf("       return report\n")
+	fmt.Printf("   }\n\n")
+	slowCPU := filepath.Join(dir, "cpu-slow.pprof")
+	elapsed, err := profileCPU(slowCPU, slowWorkload)
+	if err != nil {
+		fmt.Printf("   ❌ CPU profile failed: %v\n\n", err)
+		printProfFooter()
+		return
+	}
+	fmt.Printf("   slowFib(%d) + concatReport(%d) ran in %v under the profiler\n\n",
+		profileFibN, profileReportLines, elapsed.Round(time.Millisecond))
+
+	// Section 3: Inside a .pprof File
+	printProfSection("3. What's Inside a .pprof File")
+	cpuProf, err := readProfile(slowCPU)
+	if err != nil {
+		fmt.Printf("   ❌ could not read profile: %v\n\n", err)
+		printProfFooter()
+		return
+	}
+	if info, err := os.Stat(slowCPU); err == nil {
+		fmt.Printf("   %s: %d bytes of gzip-compressed protocol buffer\n", filepath.Base(slowCPU), info.Size())
+	}
+	fmt.Printf("   Sample types: %s\n", strings.Join(cpuProf.sampleTypes, ", "))
+	fmt.Printf("   %d samples, each one a call stack plus its values\n\n", len(cpuProf.samples))
+	if sample, ok := heaviestSample(cpuProf); ok {
+		fmt.Printf("   The heaviest sample's stack (leaf first, repeats collapsed):\n")
+		for i := 0; i < len(sample.stack); {
+			j := i + 1
+			for j < len(sample.stack) && sample.stack[j] == sample.stack[i] {
+				j++
+			}
+			if j-i > 1 {
+				fmt.Printf("      %s ×%d\n", sample.stack[i], j-i)
+			} else {
+				fmt.Printf("      %s\n", sample.stack[i])
+			}
+			i = j
+		}
+		fmt.Println()
+	}
+	fmt.Printf("   💡 \"flat\" counts samples where a function is the leaf; \"cum\"\n")
+	fmt.Printf("      counts samples where it appears anywhere on the stack\n\n")
+
+	// Section 4: The CPU Top View
+	printProfSection("4. The CPU \"top\" View")
+	shown := printProfTop(cpuProf, "cpu", 8, formatProfDuration)
+	var runtimeFrames []string
+	for _, e := range shown {
+		if e.flat > 0 && !strings.HasPrefix(e.name, "main.") {
+			runtimeFrames = append(runtimeFrames, e.name)
+		}
+	}
+	fmt.Printf("   💡 slowFib burns CPU itself (high flat); concatReport's cost hides\n")
+	fmt.Printf("      in the runtime - copying strings and GC work on the discarded copies\n")
+	if len(runtimeFrames) > 0 {
+		fmt.Printf("      (this run: %s)\n", strings.Join(runtimeFrames[:min(3, len(runtimeFrames))], ", "))
+	}
+	fmt.Println()
+
+	// Section 5: A Heap Profile
+	printProfSection("5. A Heap Profile")
+	fmt.Printf("   runtime.MemProfileRate (default) = %d: about one sample per 512 KB allocated\n\n", runtime.MemProfileRate)
+	fmt.Printf("   runtime.GC()                 // heap profiles report as of the last GC\n")
+	fmt.Printf("   pprof.WriteHeapProfile(f)\n\n")
+	slowHeap := filepath.Join(dir, "heap-slow.pprof")
+	heapProf, err := profileHeap(slowHeap, func() { runtimeSink = concatReport(profileReportLines) })
+	if err != nil {
+		fmt.Printf("   ❌ heap profile failed: %v\n\n", err)
+	} else {
+		fmt.Printf("   Sample types: %s\n", strings.Join(heapProf.sampleTypes[:2], ", "))
+		fmt.Printf("                 %s\n\n", strings.Join(heapProf.sampleTypes[2:], ", "))
+		printProfTop(heapProf, "alloc_space", 6, formatProfBytes)
+		fmt.Printf("   💡 alloc_space covers everything allocated since the program\n")
+		fmt.Printf("      started; inuse_space only what is still live\n\n")
+	}
+
+	// Section 6: The Optimized Versions
+	printProfSection("6. The Optimized Versions")
+	fmt.Printf("   func fastFib(n int) int {           // linear, no recursion\n")
+	fmt.Printf("       a, b := 0, 1\n")
+	fmt.Printf("       for range n {\n")
+	fmt.Printf("           a, b = b, a+b\n")
+	fmt.Printf("       }\n")
+	fmt.Printf("       return a\n")
+	fmt.Printf("   }\n\n")
+	fmt.Printf("   func builderReport(n int) string {\n")
+	fmt.Printf("       var b strings.Builder\n")
+	fmt.Printf("       b.Grow(n * 16)                  // one allocation up front\n")
+	fmt.Printf("       for i := range n {\n")
+	fmt.Printf("           b.WriteString(\"line \")\n")
+	fmt.Printf("           b.Write(strconv.AppendInt(digits[:0], int64(i), 10))\n")
+	fmt.Printf("           b.WriteString(\": ok\\n\")\n")
+	fmt.Printf("       }\n")
+	fmt.Printf("       return b.String()\n")
+	fmt.Printf("   }\n\n")
+	fastCPU := filepath.Join(dir, "cpu-fast.pprof")
+	if _, err := profileCPU(fastCPU, fastWorkload); err != nil {
+		fmt.Printf("   ❌ CPU profile failed: %v\n\n", err)
+	} else if fastProf, err := readProfile(fastCPU); err != nil {
+		fmt.Printf("   ❌ could not read profile: %v\n\n", err)
+	} else {
+		fmt.Printf("   Profiling fastWorkload: %d samples", len(fastProf.samples))
+		if len(fastProf.samples) == 0 {
+			fmt.Printf(" - it finished before the first 10ms tick\n\n")
+		} else {
+			fmt.Printf("\n\n")
+			printProfTop(fastProf, "cpu", 5, formatProfDuration)
+		}
+	}
+	if slowFib(20) != fastFib(20) || concatReport(50) != builderReport(50) {
+		fmt.Printf("   ❌ the optimized versions disagree with the originals!\n\n")
+	} else {
+		fmt.Printf("   ✅ Both optimized versions return the same results\n\n")
+	}
+
+	// Section 7: Before and After
+	printProfSection("7. Before and After")
+	fmt.Printf("   ┌────────────────────────────┬────────────┬────────────┬────────────┐\n")
+	fmt.Printf("   │ Measurement                │ Slow       │ Fast       │ Speedup    │\n")
+	fmt.Printf("   ├────────────────────────────┼────────────┼────────────┼────────────┤\n")
+	slowFibRun := measureMemory(func() { runtimeSink = slowFib(profileFibN) })
+	fastFibRun := measureMemory(func() { runtimeSink = fastFib(profileFibN) })
+	slowReportRun := measureMemory(func() { runtimeSink = concatReport(profileReportLines) })
+	fastReportRun := measureMemory(func() { runtimeSink = builderReport(profileReportLines) })
+	printProfCompare(fmt.Sprintf("fib(%d) time", profileFibN),
+		slowFibRun.elapsed, fastFibRun.elapsed, formatProfDuration)
+	printProfCompare(fmt.Sprintf("report(%d) time", profileReportLines),
+		slowReportRun.elapsed, fastReportRun.elapsed, formatProfDuration)
+	printProfCompare(fmt.Sprintf("report(%d) bytes", profileReportLines),
+		slowReportRun.bytes, fastReportRun.bytes, formatProfBytes)
+	printProfCompare(fmt.Sprintf("report(%d) allocs", profileReportLines),
+		slowReportRun.allocs, fastReportRun.allocs, func(n uint64) string { return strconv.FormatUint(n, 10) })
+	fmt.Printf("   └────────────────────────────┴────────────┴────────────┴────────────┘\n")
+	fmt.Printf("   💡 Profile first, then fix the algorithm - the biggest wins come\n")
+	fmt.Printf("      from doing less work, not from micro-tuning\n\n")
+
+	// Section 8: The Same View from go tool pprof
+	printProfSection("8. The Same View from go tool pprof")
+	fmt.Printf("   $ go tool pprof -top -nodecount=5 %s\n", filepath.Base(slowCPU))
+	output, err := runGoTool(dir, "tool", "pprof", "-top", "-nodecount=5", slowCPU)
+	printToolOutput(output)
+	if err != nil {
+		fmt.Printf("   ⚠️  go tool pprof failed: %v\n", err)
+	}
+	fmt.Printf("\n   💡 For a long-running server, import _ \"net/http/pprof\" and point\n")
+	fmt.Printf("      go tool pprof at /debug/pprof/profile instead\n\n")
+
+	printProfFooter()
+}
+
+// Helper functions for demonstrations
+
+func slowFib(n int) int {
+	if n < 2 {
+		return n
+	}
+	return slowFib(n-1) + slowFib(n-2)
+}
+
+func fastFib(n int) int {
+	a, b := 0, 1
+	for range n {
+		a, b = b, a+b
+	}
+	return a
+}
+
+func concatReport(n int) string {
+	report := ""
+	for i := range n {
+		report += "line " + strconv.Itoa(i) + ": ok\n"
+	}
+	return report
+}
+
+func builderReport(n int) string {
+	var b strings.Builder
+	var digits [20]byte
+	b.Grow(n * 16)
+	for i := range n {
+		b.WriteString("line ")
+		b.Write(strconv.AppendInt(digits[:0], int64(i), 10))
+		b.WriteString(": ok\n")
+	}
+	return b.String()
+}
+
+func slowWorkload() {
+	runtimeSink = slowFib(profileFibN)
+	runtimeSink = concatReport(profileReportLines)
+}
+
+func fastWorkload() {
+	runtimeSink = fastFib(profileFibN)
+	runtimeSink = builderReport(profileReportLines)
+}
+
+func profileCPU(path string, workload func()) (elapsed time.Duration, err error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+	if err := pprof.StartCPUProfile(f); err != nil {
+		return 0, err
+	}
+	start := time.Now()
+	workload()
+	elapsed = time.Since(start)
+	pprof.StopCPUProfile()
+	return elapsed, nil
+}
+
+func profileHeap(path string, workload func()) (*profileData, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	workload()
+	runtime.GC()
+	err = pprof.WriteHeapProfile(f)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	return readProfile(path)
+}
+
+// profileData is the part of profile.proto the lesson needs: the sample
+// types and, for each sample, its values and a symbolized stack.
+type profileData struct {
+	sampleTypes []string
+	samples     []profileSample
+}
+
+type profileSample struct {
+	stack  []string // function names, leaf first
+	values []int64
+}
+
+type profileEntry struct {
+	name      string
+	flat, cum int64
+}
+
+func readProfile(path string) (*profileData, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, err
+	}
+	return decodeProfile(data)
+}
+
+// decodeProfile understands the handful of profile.proto fields used here:
+// Profile.sample_type (1), sample (2), location (4), function (5) and
+// string_table (6).
+func decodeProfile(data []byte) (*profileData, error) {
+	type rawSample struct {
+		locations []uint64
+		values    []int64
+	}
+	var (
+		table       []string
+		valueTypes  [][2]uint64
+		rawSamples  []rawSample
+		locations   = make(map[uint64][]uint64) // location id → function ids
+		functionIDs = make(map[uint64]uint64)   // function id → name index
+	)
+	err := walkProto(data, func(field, wire int, v uint64, b []byte) error {
+		switch field {
+		case 1:
+			var vt [2]uint64
+			return walkProto(b, func(f, _ int, v uint64, _ []byte) error {
+				if f == 1 || f == 2 {
+					vt[f-1] = v
+				}
+				if f == 2 {
+					valueTypes = append(valueTypes, vt)
+				}
+				return nil
+			})
+		case 2:
+			var s rawSample
+			err := walkProto(b, func(f, w int, v uint64, b []byte) error {
+				switch f {
+				case 1:
+					s.locations = appendVarints(s.locations, w, v, b)
+				case 2:
+					for _, n := range appendVarints(nil, w, v, b) {
+						s.values = append(s.values, int64(n))
+					}
+				}
+				return nil
+			})
+			rawSamples = append(rawSamples, s)
+			return err
+		case 4:
+			var id uint64
+			var funcs []uint64
+			err := walkProto(b, func(f, _ int, v uint64, b []byte) error {
+				switch f {
+				case 1:
+					id = v
+				case 4:
+					return walkProto(b, func(f, _ int, v uint64, _ []byte) error {
+						if f == 1 {
+							funcs = append(funcs, v)
+						}
+						return nil
+					})
+				}
+				return nil
+			})
+			locations[id] = funcs
+			return err
+		case 5:
+			var id, name uint64
+			err := walkProto(b, func(f, _ int, v uint64, _ []byte) error {
+				switch f {
+				case 1:
+					id = v
+				case 2:
+					name = v
+				}
+				return nil
+			})
+			functionIDs[id] = name
+			return err
+		case 6:
+			table = append(table, string(b))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lookup := func(i uint64) string {
+		if i < uint64(len(table)) {
+			return table[i]
+		}
+		return "?"
+	}
+	prof := &profileData{}
+	for _, vt := range valueTypes {
+		prof.sampleTypes = append(prof.sampleTypes, lookup(vt[0])+"/"+lookup(vt[1]))
+	}
+	for _, raw := range rawSamples {
+		sample := profileSample{values: raw.values}
+		for _, loc := range raw.locations {
+			for _, fn := range locations[loc] {
+				sample.stack = append(sample.stack, lookup(functionIDs[fn]))
+			}
+		}
+		prof.samples = append(prof.samples, sample)
+	}
+	return prof, nil
+}
+
+// walkProto calls visit for each field of a protobuf message. Varint and
+// fixed-width fields arrive in v; length-delimited fields arrive in b.
+func walkProto(data []byte, visit func(field, wire int, v uint64, b []byte) error) error {
+	for len(data) > 0 {
+		key, n := binary.Uvarint(data)
+		if n <= 0 {
+			return errors.New("profile: bad field key")
+		}
+		data = data[n:]
+		field, wire := int(key>>3), int(key&7)
+		var v uint64
+		var b []byte
+		switch wire {
+		case 0:
+			v, n = binary.Uvarint(data)
+			if n <= 0 {
+				return errors.New("profile: bad varint")
+			}
+			data = data[n:]
+		case 1, 5:
+			size := 8
+			if wire == 5 {
+				size = 4
+			}
+			if len(data) < size {
+				return errors.New("profile: truncated fixed field")
+			}
+			data = data[size:]
+		case 2:
+			length, n := binary.Uvarint(data)
+			if n <= 0 || uint64(len(data)-n) < length {
+				return errors.New("profile: bad length")
+			}
+			b = data[n : n+int(length)]
+			data = data[n+int(length):]
+		default:
+			return fmt.Errorf("profile: unsupported wire type %d", wire)
+		}
+		if err := visit(field, wire, v, b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendVarints handles repeated integer fields, which may be written
+// one value at a time or packed into a single length-delimited field.
+func appendVarints(dst []uint64, wire int, v uint64, b []byte) []uint64 {
+	if wire != 2 {
+		return append(dst, v)
+	}
+	for len(b) > 0 {
+		n, size := binary.Uvarint(b)
+		if size <= 0 {
+			break
+		}
+		dst = append(dst, n)
+		b = b[size:]
+	}
+	return dst
+}
+
+func sampleTypeIndex(prof *profileData, name string) int {
+	for i, st := range prof.sampleTypes {
+		if strings.HasPrefix(st, name+"/") {
+			return i
+		}
+	}
+	return len(prof.sampleTypes) - 1
+}
+
+func heaviestSample(prof *profileData) (profileSample, bool) {
+	if len(prof.samples) == 0 {
+		return profileSample{}, false
+	}
+	index := sampleTypeIndex(prof, "cpu")
+	return stdslices.MaxFunc(prof.samples, func(a, b profileSample) int {
+		return cmp.Compare(a.values[index], b.values[index])
+	}), true
+}
+
+// topFunctions aggregates flat and cumulative values per function, the
+// same numbers `go tool pprof -top` prints.
+func topFunctions(prof *profileData, index int) (entries []profileEntry, total int64) {
+	byName := make(map[string]*profileEntry)
+	entry := func(name string) *profileEntry {
+		if e, ok := byName[name]; ok {
+			return e
+		}
+		e := &profileEntry{name: name}
+		byName[name] = e
+		return e
+	}
+	for _, sample := range prof.samples {
+		value := sample.values[index]
+		total += value
+		if len(sample.stack) == 0 {
+			continue
+		}
+		entry(sample.stack[0]).flat += value
+		seen := make(map[string]bool)
+		for _, name := range sample.stack {
+			if !seen[name] {
+				seen[name] = true
+				entry(name).cum += value
+			}
+		}
+	}
+	for _, e := range byName {
+		entries = append(entries, *e)
+	}
+	stdslices.SortFunc(entries, func(a, b profileEntry) int {
+		return cmp.Or(cmp.Compare(b.flat, a.flat), cmp.Compare(b.cum, a.cum), strings.Compare(a.name, b.name))
+	})
+	return entries, total
+}
+
+func formatProfDuration[T int64 | time.Duration](v T) string {
+	d := time.Duration(v)
+	if d >= time.Millisecond {
+		return d.Round(10 * time.Microsecond).String()
+	}
+	return d.Round(10 * time.Nanosecond).String()
+}
+
+func formatProfBytes[T int64 | uint64](v T) string {
+	return formatBytes(uint64(v))
+}
+
+// Print helper functions
+
+func printProfTop(prof *profileData, sampleType string, n int, format func(int64) string) (shown []profileEntry) {
+	entries, total := topFunctions(prof, sampleTypeIndex(prof, sampleType))
+	fmt.Printf("   %s total: %s\n", prof.sampleTypes[sampleTypeIndex(prof, sampleType)], format(total))
+	fmt.Printf("   ┌──────────┬────────┬──────────┬────────┬────────────────────────────────┐\n")
+	fmt.Printf("   │ flat     │ flat%%  │ cum      │ cum%%   │ function                       │\n")
+	fmt.Printf("   ├──────────┼────────┼──────────┼────────┼────────────────────────────────┤\n")
+	percent := func(v int64) float64 {
+		if total == 0 {
+			return 0
+		}
+		return 100 * float64(v) / float64(total)
+	}
+	shown = entries[:min(n, len(entries))]
+	for _, e := range shown {
+		name := e.name
+		if len(name) > 30 {
+			name = name[:29] + "…"
+		}
+		fmt.Printf("   │ %-8s │ %5.1f%% │ %-8s │ %5.1f%% │ %-30s │\n",
+			format(e.flat), percent(e.flat), format(e.cum), percent(e.cum), name)
+	}
+	fmt.Printf("   └──────────┴────────┴──────────┴────────┴────────────────────────────────┘\n")
+	return shown
+}
+
+func printProfCompare[T time.Duration | uint64](name string, slow, fast T, format func(T) string) {
+	speedup := "∞"
+	if fast > 0 {
+		speedup = fmt.Sprintf("%.0f×", float64(slow)/float64(fast))
+	}
+	fmt.Printf("   │ %-26s │ %-10s │ %-10s │ %-10s │\n", name, format(slow), format(fast), speedup)
+}
+
+func printProfHeader(title string) {
+	fmt.Println("\n" + strings.Repeat("=", 60))
+	fmt.Printf("  %s\n", title)
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
+
+func printProfSection(title string) {
+	fmt.Printf("┌─ %s\n", title)
+	fmt.Println("│")
+}
+
+func printProfFooter() {
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println("  ✅ Tutorial Complete!")
+	fmt.Println("  💡 Key Takeaways:")
+	fmt.Println("     • runtime/pprof writes CPU and heap profiles in-process")
+	fmt.Println("     • A profile is a gzipped protobuf of sampled call stacks")
+	fmt.Println("     • flat = time in the function, cum = including callees")
+	fmt.Println("     • Measure before and after every optimization")
+	fmt.Println("     • Algorithmic fixes beat micro-optimizations")
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
@@ -0,0 +1,602 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func reflection() {
+	printReflectHeader("GO REFLECTION TUTORIAL")
+
+	// Section 1: TypeOf and ValueOf
+	printReflectSection("1. reflect.TypeOf and reflect.ValueOf")
+	fmt.Printf("   TypeOf answers 'what is it?', ValueOf answers 'what does it hold?'\n\n")
+	alice := Person{name: "Alice", age: 30, city: "New York"}
+	fmt.Printf("   ┌──────────────────────────┬──────────────────────┬──────────────────────┐\n")
+	fmt.Printf("   │ Expression               │ TypeOf               │ ValueOf              │\n")
+	fmt.Printf("   ├──────────────────────────┼──────────────────────┼──────────────────────┤\n")
+	for _, row := range []struct {
+		expr  string
+		value any
+	}{
+		{"42", 42},
+		{"\"gopher\"", "gopher"},
+		{"3.14", 3.14},
+		{"alice (Person)", alice},
+		{"&alice", &alice},
+		{"[]int{1, 2, 3}", []int{1, 2, 3}},
+		{"map[string]int{\"a\": 1}", map[string]int{"a": 1}},
+		{"add (func)", add},
+	} {
+		value := fmt.Sprint(reflect.ValueOf(row.value))
+		if reflect.ValueOf(row.value).Kind() == reflect.Pointer || reflect.ValueOf(row.value).Kind() == reflect.Func {
+			value = "0xc000… (address)"
+		}
+		fmt.Printf("   │ %-24s │ %-20s │ %-20s │\n", row.expr, reflect.TypeOf(row.value), value)
+	}
+	fmt.Printf("   └──────────────────────────┴──────────────────────┴──────────────────────┘\n")
+	fmt.Printf("   💡 Both take an 'any', so the static type is lost and only the\n")
+	fmt.Printf("      dynamic type stored in the interface is seen\n\n")
+
+	// Section 2: Kind vs Type
+	printReflectSection("2. Kind vs Type")
+	fmt.Printf("   Type is the exact type; Kind is the underlying category.\n\n")
+	fmt.Printf("   ┌──────────────────────────┬──────────────────────┬────────────┬─────────────┐\n")
+	fmt.Printf("   │ Value                    │ Type                 │ Kind       │ Type.Name() │\n")
+	fmt.Printf("   ├──────────────────────────┼──────────────────────┼────────────┼─────────────┤\n")
+	for _, row := range []struct {
+		expr  string
+		value any
+	}{
+		{"Temperature(21.5)", Temperature(21.5)},
+		{"21.5", 21.5},
+		{"alice", alice},
+		{"Employee{}", Employee{}},
+		{"Rectangle{}", Rectangle{}},
+		{"[]Rectangle{}", []Rectangle{}},
+		{"errStudentNotFound", errStudentNotFound},
+	} {
+		t := reflect.TypeOf(row.value)
+		name := t.Name()
+		if name == "" {
+			name = "(unnamed)"
+		}
+		fmt.Printf("   │ %-24s │ %-20s │ %-10s │ %-11s │\n", row.expr, t, t.Kind(), name)
+	}
+	fmt.Printf("   └──────────────────────────┴──────────────────────┴────────────┴─────────────┘\n")
+	fmt.Printf("   TypeOf(Temperature(0)) == TypeOf(0.0)        → %t\n",
+		reflect.TypeOf(Temperature(0)) == reflect.TypeOf(0.0))
+	fmt.Printf("   TypeOf(Temperature(0)).Kind() == Float64     → %t\n",
+		reflect.TypeOf(Temperature(0)).Kind() == reflect.Float64)
+	fmt.Printf("   💡 Switch on Kind to write code that handles every struct, every\n")
+	fmt.Printf("      slice or every float - compare Types to match one exact type\n\n")
+
+	// Section 3: Walking Struct Fields
+	printReflectSection("3. Walking Struct Fields")
+	eve := Employee{name: "Eve", age: 28, address: Address{street: "1 Main St", city: "Boston", zipCode: "02101"}}
+	fmt.Printf("   t := reflect.TypeOf(eve); v := reflect.ValueOf(eve)\n")
+	fmt.Printf("   for i := range t.NumField() { t.Field(i), v.Field(i) }\n\n")
+	fmt.Printf("   ┌───────────┬──────────────┬────────┬──────────┬─────────────┐\n")
+	fmt.Printf("   │ Field     │ Type         │ Kind   │ Exported │ Value       │\n")
+	fmt.Printf("   ├───────────┼──────────────┼────────┼──────────┼─────────────┤\n")
+	printReflectFields(reflect.ValueOf(eve), "")
+	fmt.Printf("   └───────────┴──────────────┴────────┴──────────┴─────────────┘\n")
+	fmt.Printf("   💡 Unexported fields can still be READ with v.Int(), v.String()...\n")
+	fmt.Printf("      but v.Interface() on them panics:\n")
+	if r, _ := capturePanic(func() { _ = reflect.ValueOf(eve).Field(0).Interface() }); r != nil {
+		fmt.Printf("      ❌ %v\n\n", r)
+	}
+
+	// Section 4: Struct Tags
+	printReflectSection("4. Reading Struct Tags")
+	fmt.Printf("   The JSON tutorial's User type carries json tags:\n\n")
+	userType := reflect.TypeOf(User{})
+	fmt.Printf("   ┌──────────┬────────────────────────┬───────────┬──────────────┐\n")
+	fmt.Printf("   │ Field    │ Raw tag                │ json name │ Options      │\n")
+	fmt.Printf("   ├──────────┼────────────────────────┼───────────┼──────────────┤\n")
+	for i := range userType.NumField() {
+		field := userType.Field(i)
+		name, options := parseJSONTag(field)
+		fmt.Printf("   │ %-8s │ %-22s │ %-9s │ %-12s │\n", field.Name, field.Tag, name, options)
+	}
+	fmt.Printf("   └──────────┴────────────────────────┴───────────┴──────────────┘\n")
+	ageField, _ := userType.FieldByName("Age")
+	fmt.Printf("   field.Tag.Get(\"json\")   → %q\n", ageField.Tag.Get("json"))
+	_, ok := ageField.Tag.Lookup("xml")
+	fmt.Printf("   field.Tag.Lookup(\"xml\") → %q, %t\n", ageField.Tag.Get("xml"), ok)
+	personName, _ := reflect.TypeOf(alice).FieldByName("name")
+	_, ok = personName.Tag.Lookup("json")
+	fmt.Printf("   Person.name has a json tag? %t - encoding/json would skip it anyway,\n", ok)
+	fmt.Printf("   since it cannot see unexported fields either\n")
+	fmt.Printf("   💡 This is exactly how encoding/json decides names, omitempty and \"-\"\n\n")
+
+	// Section 5: Setting Values
+	printReflectSection("5. Setting Values Through Pointers")
+	x := 10
+	fmt.Printf("   x := 10\n")
+	fmt.Printf("   reflect.ValueOf(x).CanSet()        → %t   (a copy of x)\n", reflect.ValueOf(x).CanSet())
+	fmt.Printf("   reflect.ValueOf(&x).Elem().CanSet() → %t\n", reflect.ValueOf(&x).Elem().CanSet())
+	reflect.ValueOf(&x).Elem().SetInt(42)
+	fmt.Printf("   reflect.ValueOf(&x).Elem().SetInt(42) → x = %d\n\n", x)
+
+	user := User{Name: "ann", Email: "ann@example.com"}
+	uv := reflect.ValueOf(&user).Elem()
+	uv.FieldByName("Name").SetString("Ann Lee")
+	uv.FieldByName("Age").SetInt(31)
+	uv.FieldByName("Tags").Set(reflect.ValueOf([]string{"admin", "ops"}))
+	fmt.Printf("   uv := reflect.ValueOf(&user).Elem()\n")
+	fmt.Printf("   uv.FieldByName(\"Name\").SetString(\"Ann Lee\")\n")
+	fmt.Printf("   uv.FieldByName(\"Age\").SetInt(31)\n")
+	fmt.Printf("   uv.FieldByName(\"Tags\").Set(reflect.ValueOf([]string{\"admin\", \"ops\"}))\n")
+	fmt.Printf("   → %+v\n\n", user)
+
+	fmt.Printf("   Person's fields are unexported, so even through a pointer:\n")
+	nameField := reflect.ValueOf(&alice).Elem().FieldByName("name")
+	fmt.Printf("   CanAddr() → %t, CanSet() → %t, String() → %q\n", nameField.CanAddr(), nameField.CanSet(), nameField.String())
+	if r, _ := capturePanic(func() { nameField.SetString("Mallory") }); r != nil {
+		fmt.Printf("   SetString(\"Mallory\") ❌ %v\n\n", r)
+	}
+
+	fmt.Printf("   A safe setter checks first instead of panicking:\n")
+	for _, attempt := range []struct {
+		desc   string
+		target any
+		field  string
+		value  any
+	}{
+		{"setField(&user, \"Email\", \"ann@go.dev\")", &user, "Email", "ann@go.dev"},
+		{"setField(&user, \"Age\", int8(40))", &user, "Age", int8(40)},
+		{"setField(user, \"Age\", 40)", user, "Age", 40},
+		{"setField(&user, \"Age\", \"forty\")", &user, "Age", "forty"},
+		{"setField(&user, \"Nickname\", \"a\")", &user, "Nickname", "a"},
+		{"setField(&alice, \"age\", 31)", &alice, "age", 31},
+	} {
+		if err := setField(attempt.target, attempt.field, attempt.value); err != nil {
+			fmt.Printf("   %-38s ❌ %v\n", attempt.desc, err)
+		} else {
+			fmt.Printf("   %-38s ✅\n", attempt.desc)
+		}
+	}
+	fmt.Printf("   → user.Email = %q, user.Age = %d\n\n", user.Email, user.Age)
+
+	// Section 6: Calling Methods Dynamically
+	printReflectSection("6. Calling Methods and Functions Dynamically")
+	rect := Rectangle{width: 10, height: 5}
+	fmt.Printf("   reflect.TypeOf(rect).NumMethod()   → %d\n", reflect.TypeOf(rect).NumMethod())
+	personType := reflect.TypeOf(&alice)
+	var personMethods []string
+	for i := range personType.NumMethod() {
+		personMethods = append(personMethods, personType.Method(i).Name)
+	}
+	fmt.Printf("   reflect.TypeOf(&alice).NumMethod() → %d %v\n", personType.NumMethod(), personMethods)
+	fmt.Printf("   ⚠️  area(), perimeter(), introduce() are unexported, so reflection\n")
+	fmt.Printf("      cannot see them - call them normally: rect.area() = %d\n", rect.area())
+	fmt.Printf("   💡 Person's only exported method is LogValue, from the Structured\n")
+	fmt.Printf("      Logging tutorial\n\n")
+
+	fmt.Printf("   Temperature (JSON tutorial) has exported methods:\n")
+	for _, t := range []reflect.Type{reflect.TypeOf(Temperature(0)), reflect.TypeOf(new(Temperature))} {
+		for i := range t.NumMethod() {
+			method := t.Method(i)
+			fmt.Printf("   %-18s %-14s %s\n", t, method.Name, method.Type)
+		}
+	}
+	temp := Temperature(21.5)
+	results := reflect.ValueOf(temp).MethodByName("MarshalJSON").Call(nil)
+	fmt.Printf("\n   reflect.ValueOf(temp).MethodByName(\"MarshalJSON\").Call(nil)\n")
+	fmt.Printf("   → %s, err = %v\n", results[0].Bytes(), results[1])
+	results = reflect.ValueOf(&temp).MethodByName("UnmarshalJSON").Call(
+		[]reflect.Value{reflect.ValueOf([]byte(`"98.6°F"`))})
+	fmt.Printf("   reflect.ValueOf(&temp).MethodByName(\"UnmarshalJSON\").Call(\"98.6°F\")\n")
+	fmt.Printf("   → temp = %.1f, err = %v\n\n", float64(temp), results[0])
+
+	fmt.Printf("   Plain functions are Values too - call them by name from a table:\n")
+	lessonFuncs := map[string]any{
+		"add":                 add,
+		"celsiusToFahrenheit": celsiusToFahrenheit,
+		"multiplier":          multiplier,
+	}
+	for _, call := range []struct {
+		name string
+		args []any
+	}{
+		{"add", []any{2, 3}},
+		{"celsiusToFahrenheit", []any{100}},
+		{"multiplier", []any{3}},
+		{"add", []any{1}},
+		{"add", []any{"2", 3}},
+	} {
+		desc := reflectCallString(call.name, call.args)
+		out, err := callFunction(lessonFuncs[call.name], call.args...)
+		if err != nil {
+			fmt.Printf("   %-26s ❌ %v\n", desc, err)
+			continue
+		}
+		result := out[0]
+		if result.Kind() == reflect.Func {
+			inner := result.Call([]reflect.Value{reflect.ValueOf(5)})
+			fmt.Printf("   %-26s → %s, called with 5 → %v\n", desc, result.Type(), inner[0])
+			continue
+		}
+		fmt.Printf("   %-26s → %v\n", desc, result)
+	}
+	fmt.Printf("   💡 Value.Call panics on a wrong argument count or type, so check\n")
+	fmt.Printf("      Type.NumIn() and Type.In(i) first, as callFunction does\n\n")
+
+	// Section 7: Interactive Inspector
+	printReflectSection("7. Interactive Reflection Inspector")
+	fmt.Printf("   Pick a value from the lessons to see its full reflected structure.\n")
+	fmt.Printf("   🔒 marks unexported fields (readable, not settable).\n\n")
+	samples := reflectSamples()
+	for i, sample := range samples {
+		fmt.Printf("   %2d. %-28s", i+1, sample.name)
+		if i%2 == 1 {
+			fmt.Println()
+		}
+	}
+	if len(samples)%2 == 1 {
+		fmt.Println()
+	}
+	fmt.Println()
+	for {
+		input := strings.TrimSpace(readLine("   👉 Number or name (empty line to finish): "))
+		if input == "" {
+			fmt.Println()
+			break
+		}
+		sample, ok := findReflectSample(samples, input)
+		if !ok {
+			fmt.Printf("   ❌ no sample %q\n\n", input)
+			continue
+		}
+		fmt.Printf("\n   %s\n", sample.source)
+		printReflectTree(reflect.ValueOf(sample.value))
+		fmt.Println()
+	}
+
+	printReflectFooter()
+}
+
+// Helper functions for demonstrations
+
+func parseJSONTag(field reflect.StructField) (name, options string) {
+	tag, ok := field.Tag.Lookup("json")
+	if !ok {
+		return field.Name, ""
+	}
+	name, options, _ = strings.Cut(tag, ",")
+	if name == "" {
+		name = field.Name
+	}
+	return name, options
+}
+
+func setField(target any, field string, value any) error {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("target must be a pointer to a struct, got %s", v.Type())
+	}
+	f := v.Elem().FieldByName(field)
+	if !f.IsValid() {
+		return fmt.Errorf("%s has no field %q", v.Elem().Type(), field)
+	}
+	if !f.CanSet() {
+		return fmt.Errorf("field %q is unexported", field)
+	}
+	arg := reflect.ValueOf(value)
+	converted, err := convertArgument(arg, f.Type())
+	if err != nil {
+		return fmt.Errorf("field %q: %w", field, err)
+	}
+	f.Set(converted)
+	return nil
+}
+
+func callFunction(fn any, args ...any) ([]reflect.Value, error) {
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Func {
+		return nil, fmt.Errorf("%T is not a function", fn)
+	}
+	t := v.Type()
+	if len(args) != t.NumIn() {
+		return nil, fmt.Errorf("want %d arguments, got %d", t.NumIn(), len(args))
+	}
+	in := make([]reflect.Value, len(args))
+	for i, arg := range args {
+		converted, err := convertArgument(reflect.ValueOf(arg), t.In(i))
+		if err != nil {
+			return nil, fmt.Errorf("argument %d: %w", i+1, err)
+		}
+		in[i] = converted
+	}
+	return v.Call(in), nil
+}
+
+// convertArgument allows exact matches and numeric-to-numeric conversions,
+// but not Go's other conversions such as int to string.
+func convertArgument(arg reflect.Value, want reflect.Type) (reflect.Value, error) {
+	if arg.Type().AssignableTo(want) {
+		return arg, nil
+	}
+	if isNumericKind(arg.Kind()) && isNumericKind(want.Kind()) {
+		return arg.Convert(want), nil
+	}
+	return reflect.Value{}, fmt.Errorf("cannot use %s as %s", arg.Type(), want)
+}
+
+func isNumericKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func reflectCallString(name string, args []any) string {
+	parts := make([]string, len(args))
+	for i, arg := range args {
+		if s, ok := arg.(string); ok {
+			parts[i] = strconv.Quote(s)
+		} else {
+			parts[i] = fmt.Sprint(arg)
+		}
+	}
+	return name + "(" + strings.Join(parts, ", ") + ")"
+}
+
+type reflectSample struct {
+	name   string
+	source string
+	value  any
+}
+
+func reflectSamples() []reflectSample {
+	alice := &Person{name: "Alice", age: 30, city: "New York"}
+	updates := make(chan string, 3)
+	updates <- "build started"
+	updates <- "tests passed"
+	return []reflectSample{
+		{"Person", `Person{name: "Bob", age: 25, city: "London"}`,
+			Person{name: "Bob", age: 25, city: "London"}},
+		{"*Person", `&Person{name: "Alice", ...}`, alice},
+		{"Employee", `Employee{name: "Eve", ..., address: Address{...}}`,
+			Employee{name: "Eve", age: 28, address: Address{street: "1 Main St", city: "Boston", zipCode: "02101"}}},
+		{"Rectangle", `Rectangle{width: 10, height: 5}`, Rectangle{width: 10, height: 5}},
+		{"Empty", `Empty{}`, Empty{}},
+		{"User", `User{Name: "Ann", ..., Tags: []string{"admin"}}`,
+			User{Name: "Ann", Email: "ann@example.com", Age: 31, Tags: []string{"admin"}, Password: "s3cret"}},
+		{"Document", `Document{AuditInfo: AuditInfo{...}, Title: "Spec"}`,
+			Document{AuditInfo: AuditInfo{CreatedBy: "ann", Version: 3}, Title: "Spec"}},
+		{"Reading", `Reading{Sensor: "roof", Temp: 21.5}`, Reading{Sensor: "roof", Temp: 21.5}},
+		{"Dog", `Dog{Animal: Animal{name: "Rex", ...}, breed: "Beagle"}`,
+			Dog{Animal: Animal{name: "Rex", sound: "Woof"}, breed: "Beagle"}},
+		{"RoboDog", `RoboDog{Animal: &Animal{...}, Robot: Robot{...}}`,
+			RoboDog{Animal: &Animal{name: "K9", sound: "Bzzt"}, Robot: Robot{model: "RX-1"}}},
+		{"StaffMember", `StaffMember{Person: ..., Address: ..., role: "chef"}`,
+			StaffMember{Person: Person{name: "Dana", age: 41, city: "Paris"},
+				Address: Address{street: "9 Rue Cler", city: "Paris", zipCode: "75007"}, role: "chef"}},
+		{"AlertService", `AlertService{Notifier: consoleNotifier{...}, name: "billing"}`,
+			AlertService{Notifier: consoleNotifier{prefix: "[console]"}, name: "billing"}},
+		{"studentScores", `var studentScores = map[string][]float64{...}`, studentScores},
+		{"[]Rectangle", `[]Rectangle{{2, 3}, {4, 5}}`, []Rectangle{{2, 3}, {4, 5}}},
+		{"wrapped error", `fmt.Errorf("lookup %q: %w", "zed", errStudentNotFound)`,
+			fmt.Errorf("lookup %q: %w", "zed", errStudentNotFound)},
+		{"add", `func add(a int, b int) int`, add},
+		{"chan string", `make(chan string, 3) holding 2 messages`, updates},
+		{"[3]bool", `[3]bool{true, false, true}`, [3]bool{true, false, true}},
+	}
+}
+
+func findReflectSample(samples []reflectSample, input string) (reflectSample, bool) {
+	if n, err := strconv.Atoi(input); err == nil {
+		if n >= 1 && n <= len(samples) {
+			return samples[n-1], true
+		}
+		return reflectSample{}, false
+	}
+	for _, sample := range samples {
+		if strings.EqualFold(sample.name, input) {
+			return sample, true
+		}
+	}
+	return reflectSample{}, false
+}
+
+func reflectLeaf(v reflect.Value) (string, bool) {
+	switch v.Kind() {
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), true
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64), true
+	case reflect.String:
+		return strconv.Quote(v.String()), true
+	case reflect.Func:
+		if v.IsNil() {
+			return "nil", true
+		}
+		return "func value", true
+	case reflect.Chan:
+		if v.IsNil() {
+			return "nil", true
+		}
+		return fmt.Sprintf("len %d, cap %d", v.Len(), v.Cap()), true
+	}
+	return "", false
+}
+
+func describeReflectValue(v reflect.Value) string {
+	if !v.IsValid() {
+		return "nil (invalid Value)"
+	}
+	kind := v.Kind()
+	summary := v.Type().String()
+	if summary != kind.String() {
+		summary += " (" + kind.String() + ")"
+	}
+	if leaf, ok := reflectLeaf(v); ok {
+		return summary + " = " + leaf
+	}
+	switch kind {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return summary + " = nil"
+		}
+	case reflect.Slice, reflect.Map:
+		if v.IsNil() {
+			return summary + " = nil"
+		}
+		return fmt.Sprintf("%s len %d", summary, v.Len())
+	case reflect.Array:
+		return fmt.Sprintf("%s len %d", summary, v.Len())
+	case reflect.Struct:
+		if v.NumField() == 1 {
+			return summary + " 1 field"
+		}
+		return fmt.Sprintf("%s %d fields", summary, v.NumField())
+	}
+	return summary
+}
+
+type reflectChild struct {
+	label string
+	value reflect.Value
+}
+
+const reflectTreeLimit = 6
+
+func reflectChildren(v reflect.Value) []reflectChild {
+	var children []reflectChild
+	switch v.Kind() {
+	case reflect.Struct:
+		t := v.Type()
+		for i := range t.NumField() {
+			field := t.Field(i)
+			label := field.Name
+			if field.Anonymous {
+				label += " (embedded)"
+			}
+			if !field.IsExported() {
+				label += " 🔒"
+			}
+			if field.Tag != "" {
+				label += " `" + string(field.Tag) + "`"
+			}
+			children = append(children, reflectChild{label, v.Field(i)})
+		}
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			label := "*"
+			if v.Kind() == reflect.Interface {
+				label = "dynamic"
+			}
+			children = append(children, reflectChild{label, v.Elem()})
+		}
+	case reflect.Slice, reflect.Array:
+		for i := range min(v.Len(), reflectTreeLimit) {
+			children = append(children, reflectChild{fmt.Sprintf("[%d]", i), v.Index(i)})
+		}
+	case reflect.Map:
+		keys := v.MapKeys()
+		labels := make(map[string]reflect.Value, len(keys))
+		for _, key := range keys {
+			label, _ := reflectLeaf(key)
+			labels[label] = key
+		}
+		sorted := make([]string, 0, len(labels))
+		for label := range labels {
+			sorted = append(sorted, label)
+		}
+		sort.Strings(sorted)
+		for _, label := range sorted[:min(len(sorted), reflectTreeLimit)] {
+			children = append(children, reflectChild{"[" + label + "]", v.MapIndex(labels[label])})
+		}
+	}
+	return children
+}
+
+func reflectHiddenCount(v reflect.Value) int {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return max(v.Len()-reflectTreeLimit, 0)
+	}
+	return 0
+}
+
+// Print helper functions
+
+func printReflectFields(v reflect.Value, indent string) {
+	t := v.Type()
+	for i := range t.NumField() {
+		field := t.Field(i)
+		fv := v.Field(i)
+		value, _ := reflectLeaf(fv)
+		if fv.Kind() == reflect.Struct {
+			value = "↓"
+		}
+		fmt.Printf("   │ %-9s │ %-12s │ %-6s │ %-8t │ %-11s │\n",
+			indent+field.Name, field.Type, fv.Kind(), field.IsExported(), value)
+		if fv.Kind() == reflect.Struct {
+			printReflectFields(fv, indent+"  ")
+		}
+	}
+}
+
+func printReflectTree(v reflect.Value) {
+	fmt.Printf("   %s\n", describeReflectValue(v))
+	printReflectBranch(v, "   ", map[uintptr]bool{})
+}
+
+func printReflectBranch(v reflect.Value, prefix string, seen map[uintptr]bool) {
+	if v.Kind() == reflect.Pointer && !v.IsNil() {
+		if seen[v.Pointer()] {
+			fmt.Printf("%s└── (already shown)\n", prefix)
+			return
+		}
+		seen[v.Pointer()] = true
+	}
+	children := reflectChildren(v)
+	hidden := reflectHiddenCount(v)
+	for i, child := range children {
+		connector, indent := "├── ", "│   "
+		if i == len(children)-1 && hidden == 0 {
+			connector, indent = "└── ", "    "
+		}
+		fmt.Printf("%s%s%s: %s\n", prefix, connector, child.label, describeReflectValue(child.value))
+		printReflectBranch(child.value, prefix+indent, seen)
+	}
+	if hidden > 0 {
+		fmt.Printf("%s└── … %d more\n", prefix, hidden)
+	}
+}
+
+func printReflectHeader(title string) {
+	fmt.Println("\n" + strings.Repeat("=", 60))
+	fmt.Printf("  %s\n", title)
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
+
+func printReflectSection(title string) {
+	fmt.Printf("┌─ %s\n", title)
+	fmt.Println("│")
+}
+
+func printReflectFooter() {
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println("  ✅ Tutorial Complete!")
+	fmt.Println("  💡 Key Takeaways:")
+	fmt.Println("     • TypeOf gives the exact type, Kind gives its category")
+	fmt.Println("     • Struct fields and tags are walkable at runtime")
+	fmt.Println("     • Setting needs a pointer and an exported field")
+	fmt.Println("     • Reflection only sees exported methods")
+	fmt.Println("     • Check NumIn and In(i) before Value.Call")
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
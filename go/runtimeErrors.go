@@ -0,0 +1,237 @@
+package main
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+func runtimeErrors() {
+	printGalleryHeader("GO RUNTIME ERROR GALLERY")
+
+	// Section 1: Why a Gallery?
+	printGallerySection("1. Why a Runtime Error Gallery?")
+	fmt.Printf("   Earlier lessons warn about these failures without showing them.\n")
+	fmt.Printf("   Here each one is triggered for real under recover(), so you can\n")
+	fmt.Printf("   recognize the exact message when it shows up in a production log.\n\n")
+	fmt.Printf("   An unrecovered runtime panic crashes the program like this:\n\n")
+	fmt.Printf("   panic: runtime error: index out of range [5] with length 5\n\n")
+	fmt.Printf("   goroutine 1 [running]:\n")
+	fmt.Printf("   main.readScore(...)\n")
+	fmt.Printf("       /app/scores.go:12 +0x1d\n")
+	fmt.Printf("   exit status 2\n\n")
+	fmt.Printf("   💡 The first line is the message; the frames below tell you where\n\n")
+
+	// Sections 2+: one gallery entry each
+	for i, entry := range runtimeFailureGallery {
+		printGallerySection(fmt.Sprintf("%d. %s", i+2, entry.title))
+		fmt.Printf("   📖 Seen in: %s\n\n", entry.lesson)
+		for _, line := range entry.code {
+			fmt.Printf("   %s\n", line)
+		}
+		fmt.Println()
+
+		r, frames := capturePanic(entry.trigger)
+		if r == nil {
+			fmt.Printf("   ✅ no panic (unexpected!)\n\n")
+			continue
+		}
+		fmt.Printf("   💥 panic: %v\n", r)
+		_, isRuntimeErr := r.(runtime.Error)
+		fmt.Printf("   Type: %T (runtime.Error: %t)\n", r, isRuntimeErr)
+		fmt.Printf("   Stack (simplified):\n")
+		for _, frame := range frames {
+			fmt.Printf("      %s\n", frame)
+		}
+		fmt.Println()
+
+		fmt.Printf("   🔧 Fix:\n")
+		for _, line := range entry.fix {
+			fmt.Printf("   %s\n", line)
+		}
+		fmt.Println()
+	}
+
+	// Final Section: Quick Reference
+	printGallerySection(fmt.Sprintf("%d. Quick Reference - Log Message → Cause", len(runtimeFailureGallery)+2))
+	fmt.Printf("   ┌─────────────────────────────────────┬──────────────────────────┐\n")
+	fmt.Printf("   │ Message starts with                 │ Likely cause             │\n")
+	fmt.Printf("   ├─────────────────────────────────────┼──────────────────────────┤\n")
+	fmt.Printf("   │ assignment to entry in nil map      │ map not made with make() │\n")
+	fmt.Printf("   │ index out of range [i] with length  │ missing bounds check     │\n")
+	fmt.Printf("   │ slice bounds out of range           │ bad slice expression     │\n")
+	fmt.Printf("   │ integer divide by zero              │ unchecked divisor        │\n")
+	fmt.Printf("   │ invalid memory address or nil ...   │ nil pointer dereference  │\n")
+	fmt.Printf("   │ interface conversion                │ unchecked type assertion │\n")
+	fmt.Printf("   └─────────────────────────────────────┴──────────────────────────┘\n\n")
+
+	printGalleryFooter()
+}
+
+// Gallery entries
+
+type runtimeFailure struct {
+	title   string
+	lesson  string
+	code    []string
+	trigger func()
+	fix     []string
+}
+
+var runtimeFailureGallery = []runtimeFailure{
+	{
+		title:  "Writing to a nil Map",
+		lesson: "Maps, section 15 - \"Cannot add to nil map! Use make() first.\"",
+		code: []string{
+			"var m map[string]int",
+			"m[\"apples\"] = 5",
+		},
+		trigger: writeToNilMap,
+		fix: []string{
+			"m := make(map[string]int)   // or map[string]int{}",
+			"m[\"apples\"] = 5",
+			"💡 Reading a nil map is fine; only writes panic",
+		},
+	},
+	{
+		title:  "Indexing Past the End of an Array",
+		lesson: "Arrays - valid indexes are 0 to len(arr)-1",
+		code: []string{
+			"arr := [5]int{10, 20, 30, 40, 50}",
+			"i := 5",
+			"fmt.Println(arr[i])",
+		},
+		trigger: indexPastArrayEnd,
+		fix: []string{
+			"if i >= 0 && i < len(arr) {",
+			"    fmt.Println(arr[i])",
+			"}",
+			"💡 A constant index like arr[5] is caught at compile time:",
+			"   invalid argument: index 5 out of bounds [0:5]",
+		},
+	},
+	{
+		title:  "Slicing Beyond Capacity",
+		lesson: "Slices, section 4 - a slice can grow only up to its capacity",
+		code: []string{
+			"nums := []int{1, 2, 3, 4, 5}",
+			"end := 8",
+			"part := nums[2:end]",
+		},
+		trigger: sliceBeyondCapacity,
+		fix: []string{
+			"end = min(end, len(nums))",
+			"part := nums[2:end]",
+		},
+	},
+	{
+		title:  "Integer Division by Zero",
+		lesson: "Functions, section 8 - divide() checks b == 0 for this reason",
+		code: []string{
+			"total, count := 10, 0",
+			"avg := total / count",
+		},
+		trigger: integerDivideByZero,
+		fix: []string{
+			"if count == 0 {",
+			"    return 0, errDivisionByZero",
+			"}",
+			"💡 Floats don't panic: 10.0 / 0.0 is +Inf",
+			"💡 A constant divisor (total / 0) is a compile error",
+		},
+	},
+	{
+		title:  "Nil Pointer Dereference",
+		lesson: "Structs, section 8 - p2 := &p1 works because p1 exists",
+		code: []string{
+			"var p *Person   // nil - points to nothing",
+			"p.age = 41",
+		},
+		trigger: nilPointerDereference,
+		fix: []string{
+			"if p != nil {",
+			"    p.age = 41",
+			"}",
+			"💡 Or always construct: p := &Person{name: \"Frank\"}",
+			"💡 Unrecovered, the log adds: [signal SIGSEGV: segmentation violation ...]",
+		},
+	},
+	{
+		title:  "Failed Type Assertion",
+		lesson: "Conditions - the type switch is the safe form of this",
+		code: []string{
+			"var value any = \"42\"",
+			"n := value.(int)",
+		},
+		trigger: failedTypeAssertion,
+		fix: []string{
+			"n, ok := value.(int)   // comma-ok never panics",
+			"if !ok {",
+			"    // handle the unexpected type",
+			"}",
+		},
+	},
+}
+
+// Trigger functions (each one panics on purpose)
+
+func writeToNilMap() {
+	var m map[string]int
+	m["apples"] = 5
+}
+
+func indexPastArrayEnd() {
+	arr := [5]int{10, 20, 30, 40, 50}
+	i := 5
+	fmt.Println(arr[i])
+}
+
+func sliceBeyondCapacity() {
+	nums := []int{1, 2, 3, 4, 5}
+	end := 8
+	part := nums[2:end]
+	fmt.Println(part)
+}
+
+func integerDivideByZero() {
+	total, count := 10, 0
+	avg := total / count
+	fmt.Println(avg)
+}
+
+func nilPointerDereference() {
+	var p *Person
+	p.age = 41
+}
+
+func failedTypeAssertion() {
+	var value any = "42"
+	n := value.(int)
+	fmt.Println(n)
+}
+
+// Print helper functions
+
+func printGalleryHeader(title string) {
+	fmt.Println("\n" + strings.Repeat("=", 60))
+	fmt.Printf("  %s\n", title)
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
+
+func printGallerySection(title string) {
+	fmt.Printf("┌─ %s\n", title)
+	fmt.Println("│")
+}
+
+func printGalleryFooter() {
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println("  ✅ Tutorial Complete!")
+	fmt.Println("  💡 Key Takeaways:")
+	fmt.Println("     • Runtime errors are panics with a runtime.Error value")
+	fmt.Println("     • The first log line names the failure; frames show where")
+	fmt.Println("     • Make maps before writing to them")
+	fmt.Println("     • Bounds-check indexes and slice expressions from input")
+	fmt.Println("     • Check divisors and pointers before using them")
+	fmt.Println("     • Use the comma-ok form for type assertions")
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
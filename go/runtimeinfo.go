@@ -0,0 +1,359 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"os"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+func runtimeInsight() {
+	printRuntimeHeader("GO RUNTIME AND GARBAGE COLLECTOR TUTORIAL")
+
+	// Section 1: The Runtime at a Glance
+	printRuntimeSection("1. The Runtime at a Glance")
+	fmt.Printf("   runtime.Version()      → %s\n", runtime.Version())
+	fmt.Printf("   runtime.GOOS/GOARCH    → %s/%s\n", runtime.GOOS, runtime.GOARCH)
+	fmt.Printf("   runtime.NumCPU()       → %d\n", runtime.NumCPU())
+	fmt.Printf("   runtime.GOMAXPROCS(0)  → %d   (0 reads without changing)\n", runtime.GOMAXPROCS(0))
+	fmt.Printf("   runtime.NumGoroutine() → %d\n\n", runtime.NumGoroutine())
+	baseline := runtime.NumGoroutine()
+	release := make(chan struct{})
+	var wg sync.WaitGroup
+	var before, during runtime.MemStats
+	runtime.ReadMemStats(&before)
+	for range 10_000 {
+		wg.Go(func() { <-release })
+	}
+	runtime.ReadMemStats(&during)
+	var stackPerGoroutine uint64
+	if during.StackInuse > before.StackInuse {
+		stackPerGoroutine = (during.StackInuse - before.StackInuse) / 10_000
+	}
+	fmt.Printf("   Started 10,000 goroutines blocked on a channel:\n")
+	fmt.Printf("   NumGoroutine()  %d → %d\n", baseline, runtime.NumGoroutine())
+	fmt.Printf("   StackInuse      %s → %s   (≈ %s per goroutine)\n",
+		formatBytes(before.StackInuse), formatBytes(during.StackInuse),
+		formatBytes(stackPerGoroutine))
+	close(release)
+	wg.Wait()
+	fmt.Printf("   After close(release) and wg.Wait(): NumGoroutine() → %d\n", waitForGoroutines(baseline))
+	fmt.Printf("   💡 Goroutine stacks start small and grow on demand - that is why\n")
+	fmt.Printf("      thousands of them are cheap\n\n")
+
+	// Section 2: Reading MemStats
+	printRuntimeSection("2. Reading runtime.MemStats")
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	fmt.Printf("   var stats runtime.MemStats\n")
+	fmt.Printf("   runtime.ReadMemStats(&stats)   // briefly stops the world\n\n")
+	fmt.Printf("   ┌──────────────┬──────────────┬──────────────────────────────────────┐\n")
+	fmt.Printf("   │ Field        │ Now          │ Meaning                              │\n")
+	fmt.Printf("   ├──────────────┼──────────────┼──────────────────────────────────────┤\n")
+	for _, row := range []struct {
+		field, value, meaning string
+	}{
+		{"HeapAlloc", formatBytes(stats.HeapAlloc), "bytes in reachable + unswept objects"},
+		{"HeapObjects", strconv.FormatUint(stats.HeapObjects, 10), "number of those objects"},
+		{"HeapSys", formatBytes(stats.HeapSys), "heap memory obtained from the OS"},
+		{"TotalAlloc", formatBytes(stats.TotalAlloc), "bytes ever allocated (only grows)"},
+		{"Mallocs", strconv.FormatUint(stats.Mallocs, 10), "objects ever allocated"},
+		{"Frees", strconv.FormatUint(stats.Frees, 10), "objects ever freed"},
+		{"NumGC", strconv.FormatUint(uint64(stats.NumGC), 10), "completed GC cycles"},
+		{"NextGC", formatBytes(stats.NextGC), "heap size that triggers the next GC"},
+		{"PauseTotalNs", time.Duration(stats.PauseTotalNs).Round(time.Microsecond).String(), "total stop-the-world pause time"},
+	} {
+		fmt.Printf("   │ %-12s │ %-12s │ %-36s │\n", row.field, row.value, row.meaning)
+	}
+	fmt.Printf("   └──────────────┴──────────────┴──────────────────────────────────────┘\n")
+	fmt.Printf("   💡 The rest of this topic reads MemStats before and after a\n")
+	fmt.Printf("      workload and prints the difference\n\n")
+
+	// Section 3: Growing a Slice
+	printRuntimeSection("3. The Cost of Growing a Slice")
+	fmt.Printf("   The Slices tutorial's section 12 appends one element at a time.\n")
+	fmt.Printf("   Capacities seen while appending 2,000 ints:\n   ")
+	for i, c := range capacitySteps(2_000) {
+		if i > 0 && i%10 == 0 {
+			fmt.Printf("\n   ")
+		}
+		fmt.Printf("%-6d", c)
+	}
+	fmt.Printf("\n   💡 Doubling only lasts until 256 elements - then growth eases\n")
+	fmt.Printf("      toward 1.25× (plus rounding up to allocator size classes)\n\n")
+	printRuntimeTableHeader()
+	var reallocs int
+	printRuntimeDelta("append 1,000,000, no prealloc", measureMemory(func() {
+		reallocs = growSlice(1_000_000, false)
+	}))
+	printRuntimeDelta("make(0, 1_000_000) then append", measureMemory(func() {
+		growSlice(1_000_000, true)
+	}))
+	printRuntimeTableFooter()
+	fmt.Printf("   Without preallocation the backing array was copied %d times\n", reallocs)
+	fmt.Printf("   💡 Every reallocation leaves the old array behind as garbage\n\n")
+
+	// Section 4: Building a Big Map
+	printRuntimeSection("4. Building a Big Map")
+	printRuntimeTableHeader()
+	var big map[int]int
+	printRuntimeDelta("map: insert 500,000", measureMemory(func() {
+		big = buildMap(500_000, false)
+	}))
+	printRuntimeDelta("make(map, 500_000) hint", measureMemory(func() {
+		runtimeSink = buildMap(500_000, true)
+	}))
+	runtimeSink = nil
+	deleted := measureMemory(func() {
+		for k := range big {
+			delete(big, k)
+		}
+		runtime.GC()
+	})
+	printRuntimeDelta("delete every key + GC", deleted)
+	printRuntimeTableFooter()
+	fmt.Printf("   After deleting every key: len(big) = %d, heap still %s\n", len(big), formatBytes(deleted.heapAfter))
+	big = nil
+	runtime.GC()
+	var afterNil runtime.MemStats
+	runtime.ReadMemStats(&afterNil)
+	fmt.Printf("   After big = nil and runtime.GC(): heap %s\n", formatBytes(afterNil.HeapAlloc))
+	fmt.Printf("   ⚠️  Maps never shrink - drop the whole map (or copy the survivors\n")
+	fmt.Printf("      to a new one) to give the memory back\n\n")
+
+	// Section 5: Garbage and GC Cycles
+	printRuntimeSection("5. Garbage and GC Cycles")
+	fmt.Printf("   churn allocates 64 MB in 1 KB pieces and keeps none of it:\n\n")
+	printRuntimeTableHeader()
+	printRuntimeDelta("churn(64 MB)", measureMemory(func() { churn(64 << 20) }))
+	printRuntimeDelta("runtime.GC()", measureMemory(runtime.GC))
+	printRuntimeTableFooter()
+	fmt.Printf("   💡 The GC runs concurrently with your code; only short pauses stop\n")
+	fmt.Printf("      the world, and runtime.GC() forces a full cycle (mostly for tests)\n\n")
+
+	// Section 6: GOGC
+	printRuntimeSection("6. Tuning GOGC with debug.SetGCPercent")
+	fmt.Printf("   GOGC=100 (the default) lets the heap grow 100%% over the live heap\n")
+	fmt.Printf("   before the next cycle. Same churn(64 MB), different settings:\n\n")
+	printRuntimeTableHeader()
+	for _, percent := range []int{25, 100, 400} {
+		previous := debug.SetGCPercent(percent)
+		printRuntimeDelta(fmt.Sprintf("SetGCPercent(%d)", percent), measureMemory(func() { churn(64 << 20) }))
+		debug.SetGCPercent(previous)
+	}
+	printRuntimeTableFooter()
+	fmt.Printf("   💡 Lower GOGC: less memory, more GC CPU. Higher GOGC: the reverse.\n")
+	fmt.Printf("      The GOGC environment variable sets the same knob at startup\n\n")
+
+	// Section 7: GOMEMLIMIT
+	printRuntimeSection("7. A Soft Memory Limit with GOMEMLIMIT")
+	currentLimit := debug.SetMemoryLimit(-1)
+	env := os.Getenv("GOMEMLIMIT")
+	if env == "" {
+		env = "(unset)"
+	}
+	fmt.Printf("   GOMEMLIMIT=%s, debug.SetMemoryLimit(-1) → %s\n\n", env, formatLimit(currentLimit))
+	previousPercent := debug.SetGCPercent(-1)
+	runtime.GC()
+	printRuntimeTableHeader()
+	printRuntimeDelta("GC off, no limit", measureMemory(func() { churn(64 << 20) }))
+	runtime.GC()
+	debug.SetMemoryLimit(24 << 20)
+	printRuntimeDelta("GC off, limit 24 MB", measureMemory(func() { churn(64 << 20) }))
+	printRuntimeTableFooter()
+	debug.SetMemoryLimit(currentLimit)
+	debug.SetGCPercent(previousPercent)
+	runtime.GC()
+	fmt.Printf("   💡 With GOGC=off the heap only collects when it nears the limit -\n")
+	fmt.Printf("      a common setup for containers with a known memory budget\n")
+	fmt.Printf("   ⚠️  The limit is soft: a live heap larger than it makes the GC run\n")
+	fmt.Printf("      constantly instead of failing\n\n")
+
+	// Section 8: sync.Pool
+	printRuntimeSection("8. Reusing Buffers with sync.Pool")
+	fmt.Printf("   Rendering 100,000 log lines into a 1 KB bytes.Buffer each:\n\n")
+	printRuntimeTableHeader()
+	printRuntimeDelta("new buffer per line", measureMemory(func() { renderLines(100_000, false) }))
+	printRuntimeDelta("bufferPool.Get / Put", measureMemory(func() { renderLines(100_000, true) }))
+	printRuntimeTableFooter()
+	fmt.Printf("   var bufferPool = sync.Pool{New: func() any { return new(bytes.Buffer) }}\n")
+	fmt.Printf("   💡 Pooled objects may be dropped at any GC - a pool is a cache,\n")
+	fmt.Printf("      not a free list, so never rely on getting the same object back\n\n")
+
+	printRuntimeFooter()
+}
+
+// Helper functions for demonstrations
+
+// runtimeSink keeps workload results reachable so the compiler cannot
+// optimize the allocations away.
+var runtimeSink any
+
+type memDelta struct {
+	allocs    uint64
+	bytes     uint64
+	gcs       uint32
+	heapAfter uint64
+	elapsed   time.Duration
+}
+
+func measureMemory(workload func()) memDelta {
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+	workload()
+	elapsed := time.Since(start)
+	runtime.ReadMemStats(&after)
+	return memDelta{
+		allocs:    after.Mallocs - before.Mallocs,
+		bytes:     after.TotalAlloc - before.TotalAlloc,
+		gcs:       after.NumGC - before.NumGC,
+		heapAfter: after.HeapAlloc,
+		elapsed:   elapsed,
+	}
+}
+
+func waitForGoroutines(target int) int {
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > target && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	return runtime.NumGoroutine()
+}
+
+func capacitySteps(n int) []int {
+	var s []int
+	steps := []int{cap(s)}
+	for i := range n {
+		s = append(s, i)
+		if cap(s) != steps[len(steps)-1] {
+			steps = append(steps, cap(s))
+		}
+	}
+	return steps[1:]
+}
+
+func growSlice(n int, prealloc bool) (reallocs int) {
+	var s []int
+	if prealloc {
+		s = make([]int, 0, n)
+	}
+	for i := range n {
+		previous := cap(s)
+		s = append(s, i)
+		if previous > 0 && cap(s) != previous {
+			reallocs++ // the first allocation has nothing to copy
+		}
+	}
+	runtimeSink = s
+	return reallocs
+}
+
+func buildMap(n int, hint bool) map[int]int {
+	var m map[int]int
+	if hint {
+		m = make(map[int]int, n)
+	} else {
+		m = make(map[int]int)
+	}
+	for i := range n {
+		m[i] = i
+	}
+	return m
+}
+
+func churn(total int) {
+	for range total / 1024 {
+		runtimeSink = make([]byte, 1024)
+	}
+	runtimeSink = nil
+}
+
+var bufferPool = sync.Pool{New: func() any { return new(bytes.Buffer) }}
+
+func renderLines(n int, pooled bool) {
+	total := 0
+	for i := range n {
+		var buf *bytes.Buffer
+		if pooled {
+			buf = bufferPool.Get().(*bytes.Buffer)
+			buf.Reset()
+		} else {
+			buf = new(bytes.Buffer)
+		}
+		buf.Grow(1024)
+		buf.WriteString("level=INFO msg=\"request done\" id=")
+		buf.Write(strconv.AppendInt(buf.AvailableBuffer(), int64(i), 10))
+		total += buf.Len()
+		if pooled {
+			bufferPool.Put(buf)
+		}
+	}
+	runtimeSink = total
+}
+
+func formatBytes(n uint64) string {
+	switch {
+	case n >= 1<<30:
+		return fmt.Sprintf("%.1f GB", float64(n)/(1<<30))
+	case n >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(n)/(1<<20))
+	case n >= 1<<10:
+		return fmt.Sprintf("%.1f KB", float64(n)/(1<<10))
+	}
+	return fmt.Sprintf("%d B", n)
+}
+
+func formatLimit(limit int64) string {
+	if limit == math.MaxInt64 {
+		return "no limit (math.MaxInt64)"
+	}
+	return formatBytes(uint64(limit))
+}
+
+// Print helper functions
+
+func printRuntimeTableHeader() {
+	fmt.Printf("   ┌────────────────────────────────┬──────────┬──────────┬──────┬──────────┬──────────┐\n")
+	fmt.Printf("   │ Workload                       │ Allocs   │ Bytes    │ GCs  │ Heap now │ Time     │\n")
+	fmt.Printf("   ├────────────────────────────────┼──────────┼──────────┼──────┼──────────┼──────────┤\n")
+}
+
+func printRuntimeDelta(name string, d memDelta) {
+	fmt.Printf("   │ %-30s │ %-8d │ %-8s │ %-4d │ %-8s │ %-8s │\n",
+		name, d.allocs, formatBytes(d.bytes), d.gcs, formatBytes(d.heapAfter), d.elapsed.Round(10*time.Microsecond))
+}
+
+func printRuntimeTableFooter() {
+	fmt.Printf("   └────────────────────────────────┴──────────┴──────────┴──────┴──────────┴──────────┘\n")
+}
+
+func printRuntimeHeader(title string) {
+	fmt.Println("\n" + strings.Repeat("=", 60))
+	fmt.Printf("  %s\n", title)
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
+
+func printRuntimeSection(title string) {
+	fmt.Printf("┌─ %s\n", title)
+	fmt.Println("│")
+}
+
+func printRuntimeFooter() {
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println("  ✅ Tutorial Complete!")
+	fmt.Println("  💡 Key Takeaways:")
+	fmt.Println("     • runtime.MemStats shows allocations and GC cycles")
+	fmt.Println("     • Preallocating slices and maps avoids repeated copies")
+	fmt.Println("     • Maps keep their memory until the whole map is dropped")
+	fmt.Println("     • GOGC trades memory for CPU; GOMEMLIMIT caps the heap")
+	fmt.Println("     • sync.Pool reuses short-lived objects between GCs")
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// Helpers for topics that write a small module to a temp directory and
+// run the local Go toolchain against it. Everything stays offline.
+
+type scratchFile struct {
+	path    string
+	content string
+}
+
+func scratchFiles(files []scratchFile) map[string]string {
+	contents := make(map[string]string, len(files))
+	for _, file := range files {
+		contents[file.path] = file.content
+	}
+	return contents
+}
+
+func writeScratchModule(prefix string, files map[string]string) (string, error) {
+	dir, err := os.MkdirTemp("", prefix)
+	if err != nil {
+		return "", err
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			os.RemoveAll(dir)
+			return "", err
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			os.RemoveAll(dir)
+			return "", err
+		}
+	}
+	return dir, nil
+}
+
+func runGoTool(dir string, args ...string) (string, error) {
+	goPath, err := exec.LookPath("go")
+	if err != nil {
+		return "", fmt.Errorf("go toolchain not found on PATH: %w", err)
+	}
+	cmd := exec.Command(goPath, args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOPROXY=off", "GOTOOLCHAIN=local")
+	output, err := cmd.CombinedOutput()
+	return strings.TrimRight(string(output), "\n"), err
+}
+
+func printToolOutput(output string) {
+	if output == "" {
+		fmt.Printf("      (no output)\n")
+		return
+	}
+	for _, line := range strings.Split(output, "\n") {
+		fmt.Printf("      %s\n", line)
+	}
+}
+
+func printScratchFile(file scratchFile) {
+	fmt.Printf("   ── %s\n", file.path)
+	for i, line := range strings.Split(strings.TrimRight(file.content, "\n"), "\n") {
+		fmt.Printf("   %3d │ %s\n", i+1, strings.ReplaceAll(line, "\t", "    "))
+	}
+	fmt.Println()
+}
@@ -120,7 +120,8 @@ func slices() {
 		demo = append(demo, i)
 		fmt.Printf("   After append(%d): len=%d, cap=%d\n", i, len(demo), cap(demo))
 	}
-	fmt.Printf("\n   💡 Go doubles capacity when reallocation is needed!\n\n")
+	fmt.Printf("\n   💡 Go doubles capacity when reallocation is needed!\n")
+	fmt.Printf("   💡 See the Runtime & GC tutorial for what each regrowth costs\n\n")
 
 	// Section 13: Copying Slices
 	printSliceSection("13. Copying Slices for Memory Efficiency")
@@ -0,0 +1,322 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+func stringsAndRunes() {
+	printRuneHeader("GO STRINGS, BYTES AND RUNES TUTORIAL")
+
+	// Section 1: What is a String?
+	printRuneSection("1. What is a String?")
+	fmt.Printf("   A string is a read-only sequence of BYTES, usually UTF-8 text.\n")
+	fmt.Printf("   ✅ byte = uint8 - one unit of storage\n")
+	fmt.Printf("   ✅ rune = int32 - one Unicode code point\n")
+	fmt.Printf("   ✅ A character on screen (grapheme) may be several runes\n\n")
+
+	// Section 2: UTF-8 Byte by Byte
+	printRuneSection("2. UTF-8 Encoding Byte by Byte")
+	sample := "Go⌘世🚀"
+	fmt.Printf("   s := %q\n\n", sample)
+	fmt.Printf("   Char  Code Point  Bytes  Encoding (binary)\n")
+	fmt.Printf("   ────  ──────────  ─────  ───────────────────────────────────\n")
+	for _, r := range sample {
+		encoded := []byte(string(r))
+		var bits []string
+		for _, b := range encoded {
+			bits = append(bits, fmt.Sprintf("%08b", b))
+		}
+		fmt.Printf("   %-4s  U+%04X      %-5d  %s\n", string(r), r, len(encoded), strings.Join(bits, " "))
+	}
+	fmt.Println()
+	fmt.Printf("   Leading bits tell the decoder how long each rune is:\n")
+	fmt.Printf("   0xxxxxxx = 1 byte (ASCII), 110xxxxx = 2, 1110xxxx = 3, 11110xxx = 4\n")
+	fmt.Printf("   10xxxxxx = continuation byte\n\n")
+
+	// Section 3: len vs utf8.RuneCountInString
+	printRuneSection("3. len() vs utf8.RuneCountInString()")
+	fmt.Printf("   len(%q)                    = %d (bytes)\n", sample, len(sample))
+	fmt.Printf("   utf8.RuneCountInString(%q) = %d (runes)\n", sample, utf8.RuneCountInString(sample))
+	fmt.Printf("   💡 The Data Types tutorial's \"Length: N characters\" is really N bytes\n\n")
+
+	// Section 4: Indexing vs Ranging
+	printRuneSection("4. Indexing vs Ranging")
+	word := "héllo"
+	fmt.Printf("   s := %q\n\n", word)
+	fmt.Printf("   Indexing gives BYTES:\n")
+	fmt.Printf("   for i := 0; i < len(s); i++ { s[i] }\n")
+	fmt.Printf("   →")
+	for i := 0; i < len(word); i++ {
+		fmt.Printf(" %d:0x%02x", i, word[i])
+	}
+	fmt.Printf("\n\n")
+
+	fmt.Printf("   Ranging gives RUNES (and byte offsets):\n")
+	fmt.Printf("   for i, r := range s { }\n")
+	fmt.Printf("   →")
+	for i, r := range word {
+		fmt.Printf(" %d:%c", i, r)
+	}
+	fmt.Printf("\n   💡 Offset 2 is skipped - 'é' takes bytes 1 and 2\n\n")
+
+	// Section 5: Conversions
+	printRuneSection("5. Converting Between string, []byte and []rune")
+	fmt.Printf("   []byte(%q) = %v\n", word, []byte(word))
+	fmt.Printf("   []rune(%q) = %v\n", word, []rune(word))
+	fmt.Printf("   string([]rune{72, 105}) = %q\n", string([]rune{72, 105}))
+	fmt.Printf("   string(rune(0x4E16))    = %q\n", string(rune(0x4E16)))
+	fmt.Printf("   strconv.Itoa(65)        = %q\n", strconv.Itoa(65))
+	fmt.Printf("   ⚠️  string(65) is \"A\", not \"65\" - go vet warns about it\n\n")
+
+	// Section 6: Runes Are Not Characters
+	printRuneSection("6. Runes Are Not Characters - reverseString Revisited")
+	fmt.Printf("   The Functions tutorial reverses runes:\n")
+	fmt.Printf("   reverseString(\"Hello\") = %q ✅\n\n", reverseString("Hello"))
+	combining := "cafe\u0301 🇯🇵"
+	fmt.Printf("   s := \"cafe\\u0301 🇯🇵\"   // 'e' + combining acute accent, then a flag\n")
+	fmt.Printf("   → displays as: %s\n", combining)
+	fmt.Printf("   → runes: %d, graphemes: %d\n\n", utf8.RuneCountInString(combining), len(splitGraphemes(combining)))
+	fmt.Printf("   reverseString(s)    = %s ❌ accent moved, flag became 🇵🇯\n", reverseString(combining))
+	fmt.Printf("   reverseGraphemes(s) = %s ✅ reverses whole characters\n\n", reverseGraphemes(combining))
+
+	// Section 7: strings.Builder Performance
+	printRuneSection("7. strings.Builder Performance")
+	const pieces = 20000
+	fmt.Printf("   Building a string from %d pieces:\n\n", pieces)
+	fmt.Printf("   s := \"\"\n")
+	fmt.Printf("   for i := 0; i < n; i++ { s += \"x\" }   // copies s every time\n\n")
+	fmt.Printf("   var sb strings.Builder\n")
+	fmt.Printf("   for i := 0; i < n; i++ { sb.WriteString(\"x\") }\n\n")
+
+	start := time.Now()
+	concatenated := ""
+	for i := 0; i < pieces; i++ {
+		concatenated += "x"
+	}
+	concatTime := time.Since(start)
+
+	start = time.Now()
+	var sb strings.Builder
+	for i := 0; i < pieces; i++ {
+		sb.WriteString("x")
+	}
+	built := sb.String()
+	builderTime := time.Since(start)
+
+	fmt.Printf("   += concatenation: %10v (len %d)\n", concatTime, len(concatenated))
+	fmt.Printf("   strings.Builder:  %10v (len %d)\n", builderTime, len(built))
+	if builderTime > 0 {
+		fmt.Printf("   → Builder was %.0fx faster\n", float64(concatTime)/float64(builderTime))
+	}
+	fmt.Printf("   💡 Call sb.Grow(n) first when you know the final size\n\n")
+
+	// Section 8: bytes.Buffer
+	printRuneSection("8. bytes.Buffer - Read AND Write")
+	var buf bytes.Buffer
+	buf.WriteString("line one\n")
+	buf.Write([]byte("line two\n"))
+	fmt.Fprintf(&buf, "line %d\n", 3)
+	fmt.Printf("   var buf bytes.Buffer\n")
+	fmt.Printf("   buf.WriteString(\"line one\\n\")\n")
+	fmt.Printf("   buf.Write([]byte(\"line two\\n\"))\n")
+	fmt.Printf("   fmt.Fprintf(&buf, \"line %%d\\n\", 3)\n")
+	fmt.Printf("   → buf.Len() = %d\n\n", buf.Len())
+	first, _ := buf.ReadString('\n')
+	fmt.Printf("   first, _ := buf.ReadString('\\n')\n")
+	fmt.Printf("   → first = %q, remaining = %q\n\n", first, buf.String())
+	fmt.Printf("   ┌─────────────────┬────────────────────────────────────────┐\n")
+	fmt.Printf("   │ strings.Builder │ Write-only, building one final string  │\n")
+	fmt.Printf("   │ bytes.Buffer    │ Read and write, an in-memory io stream │\n")
+	fmt.Printf("   └─────────────────┴────────────────────────────────────────┘\n\n")
+
+	// Section 9: strings Package Essentials
+	printRuneSection("9. strings Package Essentials")
+	text := "  Go is fun, Go is fast  "
+	trimmed := strings.TrimSpace(text)
+	fmt.Printf("   s := %q\n", text)
+	fmt.Printf("   t := strings.TrimSpace(s)        → %q\n\n", trimmed)
+	fmt.Printf("   strings.Contains(s, \"fun\")       → %t\n", strings.Contains(text, "fun"))
+	fmt.Printf("   strings.HasPrefix(t, \"Go\")       → %t\n", strings.HasPrefix(trimmed, "Go"))
+	fmt.Printf("   strings.Index(t, \"is\")           → %d\n", strings.Index(trimmed, "is"))
+	fmt.Printf("   strings.Count(t, \"Go\")           → %d\n", strings.Count(trimmed, "Go"))
+	fmt.Printf("   strings.Split(t, \", \")           → %q\n", strings.Split(trimmed, ", "))
+	fmt.Printf("   strings.Fields(t)                → %q\n", strings.Fields(trimmed))
+	fmt.Printf("   strings.Join([]string{\"a\",\"b\"}, \"-\") → %q\n", strings.Join([]string{"a", "b"}, "-"))
+	fmt.Printf("   strings.ReplaceAll(t, \"Go\", \"Rust\") → %q\n", strings.ReplaceAll(trimmed, "Go", "Rust"))
+	fmt.Printf("   strings.ToUpper(t)               → %q\n", strings.ToUpper(trimmed))
+	fmt.Printf("   strings.EqualFold(\"Go\", \"GO\")    → %t\n", strings.EqualFold("Go", "GO"))
+	before, after, found := strings.Cut("key=value", "=")
+	fmt.Printf("   strings.Cut(\"key=value\", \"=\")    → %q, %q, %t\n", before, after, found)
+	fmt.Printf("   strings.Repeat(\"ab\", 3)          → %q\n\n", strings.Repeat("ab", 3))
+
+	// Section 10: Interactive Explorer
+	printRuneSection("10. Interactive String Explorer")
+	fmt.Printf("   Type any text to see its bytes, runes and graphemes.\n")
+	fmt.Printf("   Go escapes like \\u0301 are decoded. Try: naïve, cafe\\u0301, 👍🏽, 👨‍👩‍👧, 🇫🇷\n\n")
+	for {
+		input := readLine("   👉 Text (empty line to finish): ")
+		if input == "" {
+			fmt.Println()
+			break
+		}
+		if strings.Contains(input, `\`) {
+			if unquoted, err := strconv.Unquote(`"` + input + `"`); err == nil {
+				input = unquoted
+			}
+		}
+		exploreString(input)
+	}
+
+	printRuneFooter()
+}
+
+// Helper functions for demonstrations
+
+func exploreString(s string) {
+	fmt.Println()
+	fmt.Printf("   Bytes (%d):", len(s))
+	for i := 0; i < len(s); i++ {
+		fmt.Printf(" %02x", s[i])
+	}
+	fmt.Println()
+	if !utf8.ValidString(s) {
+		fmt.Printf("   ⚠️  Not valid UTF-8 - invalid bytes decode as U+FFFD\n")
+	}
+
+	fmt.Printf("   Runes (%d):\n", utf8.RuneCountInString(s))
+	fmt.Printf("      offset  rune        code point  bytes  category\n")
+	for offset, r := range s {
+		_, size := utf8.DecodeRuneInString(s[offset:])
+		category := runeCategory(r)
+		if r == utf8.RuneError && size == 1 {
+			category = "invalid byte (decoded as U+FFFD)"
+		}
+		fmt.Printf("      %-6d  %-10s  U+%04X      %-5d  %s\n",
+			offset, strconv.QuoteRune(r), r, size, category)
+	}
+
+	graphemes := splitGraphemes(s)
+	fmt.Printf("   Graphemes (%d):", len(graphemes))
+	for _, g := range graphemes {
+		fmt.Printf(" [%s]", g)
+	}
+	fmt.Printf("\n\n")
+}
+
+func runeCategory(r rune) string {
+	switch {
+	case r == utf8.RuneError:
+		return "replacement character"
+	case r == '\u200D':
+		return "zero width joiner"
+	case isEmojiModifier(r):
+		return "emoji skin tone modifier"
+	case isRegionalIndicator(r):
+		return "regional indicator (flag half)"
+	case unicode.Is(unicode.Mn, r), unicode.Is(unicode.Me, r), unicode.Is(unicode.Mc, r):
+		return "combining mark"
+	case unicode.IsLetter(r):
+		return "letter"
+	case unicode.IsDigit(r):
+		return "digit"
+	case unicode.IsSpace(r):
+		return "space"
+	case unicode.IsPunct(r):
+		return "punctuation"
+	case unicode.IsSymbol(r):
+		return "symbol"
+	case unicode.IsControl(r):
+		return "control"
+	}
+	return "other"
+}
+
+// splitGraphemes is a simplified version of Unicode's extended grapheme
+// cluster rules: it joins combining marks, zero width joiner sequences,
+// emoji modifiers, flag pairs and CRLF. Full segmentation lives in
+// golang.org/x/text or github.com/rivo/uniseg.
+func splitGraphemes(s string) []string {
+	var clusters []string
+	var current []rune
+	joinNext := false
+
+	for _, r := range s {
+		if len(current) > 0 {
+			last := current[len(current)-1]
+			pairsFlag := isRegionalIndicator(r) && isRegionalIndicator(last) && countTrailingRegional(current)%2 == 1
+			crlf := last == '\r' && r == '\n'
+			if joinNext || isGraphemeExtender(r) || pairsFlag || crlf {
+				current = append(current, r)
+				joinNext = r == '\u200D'
+				continue
+			}
+			clusters = append(clusters, string(current))
+		}
+		current = []rune{r}
+		joinNext = false
+	}
+	if len(current) > 0 {
+		clusters = append(clusters, string(current))
+	}
+	return clusters
+}
+
+func reverseGraphemes(s string) string {
+	clusters := splitGraphemes(s)
+	for i, j := 0, len(clusters)-1; i < j; i, j = i+1, j-1 {
+		clusters[i], clusters[j] = clusters[j], clusters[i]
+	}
+	return strings.Join(clusters, "")
+}
+
+func isGraphemeExtender(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc) || r == '\u200D' || isEmojiModifier(r)
+}
+
+func isEmojiModifier(r rune) bool {
+	return r >= 0x1F3FB && r <= 0x1F3FF
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
+
+func countTrailingRegional(runes []rune) int {
+	count := 0
+	for i := len(runes) - 1; i >= 0 && isRegionalIndicator(runes[i]); i-- {
+		count++
+	}
+	return count
+}
+
+// Print helper functions
+
+func printRuneHeader(title string) {
+	fmt.Println("\n" + strings.Repeat("=", 60))
+	fmt.Printf("  %s\n", title)
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
+
+func printRuneSection(title string) {
+	fmt.Printf("┌─ %s\n", title)
+	fmt.Println("│")
+}
+
+func printRuneFooter() {
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println("  ✅ Tutorial Complete!")
+	fmt.Println("  💡 Key Takeaways:")
+	fmt.Println("     • Strings are read-only UTF-8 bytes")
+	fmt.Println("     • len() counts bytes; utf8.RuneCountInString() counts runes")
+	fmt.Println("     • s[i] is a byte; range s yields runes")
+	fmt.Println("     • One visible character can be several runes")
+	fmt.Println("     • Use strings.Builder to build strings in loops")
+	fmt.Println("     • bytes.Buffer is a readable and writable byte stream")
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
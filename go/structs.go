@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"strings"
+	"unsafe"
 )
 
 func structs() {
@@ -186,15 +187,17 @@ func structs() {
 	fmt.Printf("       Email string `json:\"email\"`\n")
 	fmt.Printf("       Age   int    `json:\"age,omitempty\"`\n")
 	fmt.Printf("   }\n\n")
-	fmt.Printf("   Tags are used for JSON encoding/decoding, validation, etc.\n\n")
+	fmt.Printf("   Tags are used for JSON encoding/decoding, validation, etc.\n")
+	fmt.Printf("   💡 The JSON Encoding topic marshals this struct for real.\n\n")
 
 	// Section 15: Empty Struct
 	printStructSection("15. Empty Struct (Zero Memory)")
 	fmt.Printf("   type Empty struct{}\n\n")
 	fmt.Printf("   var e Empty\n")
 	var e Empty
-	fmt.Printf("   → Size: 0 bytes (useful for sets, signals)\n")
-	fmt.Printf("   → Value: %+v\n\n", e)
+	fmt.Printf("   → unsafe.Sizeof(e): %d bytes (useful for sets, signals)\n", unsafe.Sizeof(e))
+	fmt.Printf("   → Value: %+v\n", e)
+	fmt.Printf("   💡 The Memory Layout tutorial measures every struct in this lesson\n\n")
 
 	// Section 16: Returning Structs from Functions
 	printStructSection("16. Returning Structs from Functions")
@@ -0,0 +1,330 @@
+package main
+
+import (
+	_ "embed"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func goTesting() {
+	printTestingHeader("GO TESTING TUTORIAL")
+
+	// Section 1: Conventions
+	printTestingSection("1. Test File Conventions")
+	fmt.Printf("   ┌───────────────────────────────────┬────────────────────────────────┐\n")
+	fmt.Printf("   │ Declaration                       │ Run by                         │\n")
+	fmt.Printf("   ├───────────────────────────────────┼────────────────────────────────┤\n")
+	fmt.Printf("   │ file name ends in _test.go        │ only built by 'go test'        │\n")
+	fmt.Printf("   │ func TestXxx(t *testing.T)        │ go test                        │\n")
+	fmt.Printf("   │ func BenchmarkXxx(b *testing.B)   │ go test -bench=.               │\n")
+	fmt.Printf("   │ func ExampleXxx()                 │ go test (checks // Output:)    │\n")
+	fmt.Printf("   │ func FuzzXxx(f *testing.F)        │ go test -fuzz=FuzzXxx          │\n")
+	fmt.Printf("   └───────────────────────────────────┴────────────────────────────────┘\n\n")
+
+	helpers, err := extractFunctions(functionsSource, "add", "factorial", "celsiusToFahrenheit", "reverseString")
+	if err != nil {
+		fmt.Printf("   ❌ could not read the Functions lesson source: %v\n\n", err)
+		printTestingFooter()
+		return
+	}
+	files := append([]scratchFile{
+		{"go.mod", "module example.com/calc\n\ngo 1.25\n"},
+		{"calc.go", "package calc\n\n" + helpers},
+	}, calcTestFiles...)
+
+	dir, err := writeScratchModule("testing-demo-", scratchFiles(files))
+	if err != nil {
+		fmt.Printf("   ❌ could not create scratch module: %v\n\n", err)
+		printTestingFooter()
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	// Section 2: Code Under Test
+	printTestingSection("2. Code Under Test")
+	fmt.Printf("   The helpers are copied straight from the Functions lesson\n")
+	fmt.Printf("   into a scratch module at %s\n\n", filepath.Base(dir))
+	printScratchFile(files[1])
+
+	// Section 3: Table-Driven Tests
+	printTestingSection("3. Table-Driven Tests, Subtests and t.Helper")
+	fmt.Printf("   • One slice of cases, one loop - adding a case is one line\n")
+	fmt.Printf("   • t.Run gives each case its own name, pass/fail and -run filter\n")
+	fmt.Printf("   • t.Helper makes failures point at the caller, not the helper\n\n")
+	printScratchFile(files[2])
+
+	// Section 4: Running Tests
+	printTestingSection("4. Running the Tests")
+	fmt.Printf("   $ go test -v -run 'Test'\n")
+	runTestingTool(dir, "test", "-v", "-run", "Test", ".")
+	fmt.Printf("   Reading the output:\n")
+	fmt.Printf("   • === RUN     a test or subtest starting\n")
+	fmt.Printf("   • --- PASS    finished, with its duration\n")
+	fmt.Printf("   • TestAdd/with_zero - spaces in subtest names become underscores\n")
+	fmt.Printf("   • Run one case with: go test -run 'TestAdd/negatives'\n\n")
+
+	// Section 5: Examples
+	printTestingSection("5. Example Functions - Documentation That Is Tested")
+	printScratchFile(files[3])
+	fmt.Printf("   $ go test -v -run 'Example'\n")
+	runTestingTool(dir, "test", "-v", "-run", "Example", ".")
+	fmt.Printf("   💡 go test compares stdout with the // Output: comment, and\n")
+	fmt.Printf("      go doc shows the example next to the documentation\n\n")
+
+	// Section 6: Benchmarks
+	printTestingSection("6. Benchmarks")
+	printScratchFile(files[4])
+	fmt.Printf("   $ go test -run '^$' -bench . -benchmem -benchtime 200ms\n")
+	runTestingTool(dir, "test", "-run", "^$", "-bench", ".", "-benchmem", "-benchtime", "200ms", ".")
+	fmt.Printf("   ┌──────────────────────┬───────────────────────────────────────┐\n")
+	fmt.Printf("   │ Column               │ Meaning                               │\n")
+	fmt.Printf("   ├──────────────────────┼───────────────────────────────────────┤\n")
+	fmt.Printf("   │ BenchmarkXxx-N       │ name; -N is GOMAXPROCS (omitted if 1) │\n")
+	fmt.Printf("   │ first number         │ iterations b.Loop() ran               │\n")
+	fmt.Printf("   │ ns/op                │ average time per iteration            │\n")
+	fmt.Printf("   │ B/op, allocs/op      │ heap memory per iteration (-benchmem) │\n")
+	fmt.Printf("   └──────────────────────┴───────────────────────────────────────┘\n")
+	fmt.Printf("   💡 Compare runs on the same machine - ns/op is not portable\n\n")
+
+	// Section 7: Fuzzing
+	printTestingSection("7. Fuzz Testing")
+	printScratchFile(files[5])
+	fmt.Printf("   $ go test -run '^$' -fuzz FuzzReverseString -fuzztime 2s\n")
+	runTestingTool(dir, "test", "-run", "^$", "-fuzz", "FuzzReverseString", "-fuzztime", "2s", ".")
+	fmt.Printf("   Reading the output:\n")
+	fmt.Printf("   • baseline coverage - the seed corpus (f.Add) runs first\n")
+	fmt.Printf("   • execs             - generated inputs tried so far\n")
+	fmt.Printf("   • new interesting   - inputs that reached new code paths\n")
+	fmt.Printf("   ⚠️  Invalid UTF-8 is skipped: []rune turns bad bytes into U+FFFD,\n")
+	fmt.Printf("      so reversing twice cannot give back the original bytes\n\n")
+
+	// Section 8: A Failing Test
+	printTestingSection("8. What a Failure Looks Like")
+	failing := scratchFile{"typo_test.go", typoTestSource}
+	if err := os.WriteFile(filepath.Join(dir, failing.path), []byte(failing.content), 0o644); err != nil {
+		fmt.Printf("   ❌ %v\n\n", err)
+	}
+	printScratchFile(failing)
+	fmt.Printf("   $ go test -run 'TestBoilingPointTypo'\n")
+	runTestingTool(dir, "test", "-run", "TestBoilingPointTypo", ".")
+	fmt.Printf("   💡 The failure is reported at typo_test.go:6 (the call) rather\n")
+	fmt.Printf("      than inside assertClose - that is what t.Helper() does\n\n")
+
+	printTestingFooter()
+}
+
+// Files for the scratch module
+
+//go:embed functions.go
+var functionsSource []byte
+
+var calcTestFiles = []scratchFile{
+	{"calc_test.go", `package calc
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestAdd(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b int
+		want int
+	}{
+		{"both positive", 2, 3, 5},
+		{"with zero", 7, 0, 7},
+		{"negatives", -4, -6, -10},
+		{"mixed signs", -5, 8, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := add(tt.a, tt.b); got != tt.want {
+				t.Errorf("add(%d, %d) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFactorial(t *testing.T) {
+	tests := []struct {
+		n, want int
+	}{
+		{0, 1},
+		{1, 1},
+		{5, 120},
+		{10, 3628800},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d!", tt.n), func(t *testing.T) {
+			if got := factorial(tt.n); got != tt.want {
+				t.Errorf("factorial(%d) = %d, want %d", tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCelsiusToFahrenheit(t *testing.T) {
+	tests := []struct {
+		name    string
+		celsius float64
+		want    float64
+	}{
+		{"freezing", 0, 32},
+		{"boiling", 100, 212},
+		{"body temperature", 37, 98.6},
+		{"same on both scales", -40, -40},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertClose(t, celsiusToFahrenheit(tt.celsius), tt.want)
+		})
+	}
+}
+
+// 37°C is 98.60000000000001°F in float64, so compare with a tolerance.
+func assertClose(t *testing.T, got, want float64) {
+	t.Helper()
+	if math.Abs(got-want) > 1e-9 {
+		t.Errorf("got %.4f, want %.4f", got, want)
+	}
+}
+`},
+	{"example_test.go", `package calc
+
+import "fmt"
+
+func Example_factorial() {
+	fmt.Println(factorial(5))
+	// Output: 120
+}
+
+func Example_reverseString() {
+	fmt.Println(reverseString("Hello, 世界"))
+	// Output: 界世 ,olleH
+}
+`},
+	{"bench_test.go", `package calc
+
+import "testing"
+
+func BenchmarkFactorial(b *testing.B) {
+	for b.Loop() {
+		factorial(20)
+	}
+}
+
+func BenchmarkReverseString(b *testing.B) {
+	for b.Loop() {
+		reverseString("Hello, 世界")
+	}
+}
+`},
+	{"fuzz_test.go", `package calc
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+func FuzzReverseString(f *testing.F) {
+	for _, seed := range []string{"", "a", "Hello", "Hello, 世界"} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		if !utf8.ValidString(s) {
+			t.Skip("reverseString works on runes, not arbitrary bytes")
+		}
+		reversed := reverseString(s)
+		if !utf8.ValidString(reversed) {
+			t.Fatalf("reverseString(%q) = %q is not valid UTF-8", s, reversed)
+		}
+		if twice := reverseString(reversed); twice != s {
+			t.Errorf("reversing %q twice gave %q", s, twice)
+		}
+	})
+}
+`},
+}
+
+const typoTestSource = `package calc
+
+import "testing"
+
+func TestBoilingPointTypo(t *testing.T) {
+	assertClose(t, celsiusToFahrenheit(100), 221) // should be 212
+}
+`
+
+// extractFunctions returns the source of the named top-level functions,
+// in the order given, formatted as gofmt would print them.
+func extractFunctions(src []byte, names ...string) (string, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "functions.go", src, parser.ParseComments)
+	if err != nil {
+		return "", err
+	}
+	found := map[string]*ast.FuncDecl{}
+	for _, decl := range file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil {
+			found[fn.Name.Name] = fn
+		}
+	}
+
+	var out strings.Builder
+	for i, name := range names {
+		fn, ok := found[name]
+		if !ok {
+			return "", fmt.Errorf("function %s not found", name)
+		}
+		if i > 0 {
+			out.WriteString("\n")
+		}
+		if err := format.Node(&out, fset, fn); err != nil {
+			return "", err
+		}
+		out.WriteString("\n")
+	}
+	return out.String(), nil
+}
+
+func runTestingTool(dir string, args ...string) {
+	output, err := runGoTool(dir, args...)
+	printToolOutput(output)
+	if err != nil {
+		fmt.Printf("   ❌ %v\n", err)
+	}
+	fmt.Println()
+}
+
+// Print helper functions
+
+func printTestingHeader(title string) {
+	fmt.Println("\n" + strings.Repeat("=", 60))
+	fmt.Printf("  %s\n", title)
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
+
+func printTestingSection(title string) {
+	fmt.Printf("┌─ %s\n", title)
+	fmt.Println("│")
+}
+
+func printTestingFooter() {
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println("  ✅ Tutorial Complete!")
+	fmt.Println("  💡 Key Takeaways:")
+	fmt.Println("     • Tests live in _test.go files next to the code")
+	fmt.Println("     • Table-driven tests with t.Run scale to many cases")
+	fmt.Println("     • t.Helper keeps failure locations useful")
+	fmt.Println("     • Examples are documentation that go test verifies")
+	fmt.Println("     • Benchmarks measure; fuzzing finds inputs you missed")
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
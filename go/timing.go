@@ -0,0 +1,336 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	// Embeds the IANA time zone database (about 450 KB) so LoadLocation
+	// works even on systems without /usr/share/zoneinfo, such as Windows.
+	_ "time/tzdata"
+)
+
+func timeAndDurations() {
+	printTimeHeader("GO TIME, DURATIONS AND TIMERS TUTORIAL")
+
+	// Section 1: time.Time
+	printTimeSection("1. time.Time Basics")
+	launch := time.Date(2009, time.November, 10, 23, 0, 0, 0, time.UTC)
+	fmt.Printf("   launch := time.Date(2009, time.November, 10, 23, 0, 0, 0, time.UTC)\n")
+	fmt.Printf("   → %v\n", launch)
+	fmt.Printf("   launch.Year(), Month(), Day()  → %d, %v, %d\n", launch.Year(), launch.Month(), launch.Day())
+	fmt.Printf("   launch.Weekday(), YearDay()    → %v, %d\n", launch.Weekday(), launch.YearDay())
+	fmt.Printf("   launch.Unix()                  → %d\n", launch.Unix())
+	var zero time.Time
+	fmt.Printf("   var zero time.Time             → %v\n", zero)
+	fmt.Printf("   zero.IsZero()                  → %t\n", zero.IsZero())
+	fmt.Printf("   💡 time.Time is a value type - pass and store it by value, not *time.Time\n\n")
+
+	// Section 2: Wall Clock vs Monotonic Clock
+	printTimeSection("2. Wall Clock vs Monotonic Clock")
+	start := time.Now()
+	time.Sleep(20 * time.Millisecond)
+	end := time.Now()
+	fmt.Printf("   start := time.Now()\n")
+	fmt.Printf("   → %v\n", start.Format("2006-01-02 15:04:05.000000 MST")+" "+monotonicPart(start))
+	fmt.Printf("   end.Sub(start) after Sleep(20ms) → %v\n\n", end.Sub(start).Round(time.Millisecond))
+	fmt.Printf("   Every time.Now() carries two readings:\n")
+	fmt.Printf("   ┌────────────┬─────────────────────────────┬──────────────────────────┐\n")
+	fmt.Printf("   │ Clock      │ Used for                    │ Can jump?                │\n")
+	fmt.Printf("   ├────────────┼─────────────────────────────┼──────────────────────────┤\n")
+	fmt.Printf("   │ wall       │ Format, Year, Unix, Equal   │ yes - NTP, manual change │\n")
+	fmt.Printf("   │ monotonic  │ Sub, Since, Until, Before   │ no - only moves forward  │\n")
+	fmt.Printf("   └────────────┴─────────────────────────────┴──────────────────────────┘\n")
+	stripped := start.Round(0)
+	fmt.Printf("   start.Round(0) strips the monotonic reading: %q\n", monotonicPart(stripped))
+	fmt.Printf("   start == start.Round(0)      → %t   (== compares the readings too)\n", start == stripped)
+	fmt.Printf("   start.Equal(start.Round(0))  → %t   (Equal compares instants)\n", start.Equal(stripped))
+	fmt.Printf("   💡 Times from Date, Parse or Unix have no monotonic reading, so\n")
+	fmt.Printf("      Sub between them uses the wall clock\n\n")
+
+	// Section 3: Durations
+	printTimeSection("3. Duration Arithmetic")
+	timeout := 90 * time.Second
+	fmt.Printf("   timeout := 90 * time.Second           → %v\n", timeout)
+	fmt.Printf("   timeout.Minutes()                     → %v\n", timeout.Minutes())
+	fmt.Printf("   timeout.Milliseconds()                → %d\n", timeout.Milliseconds())
+	retries := 3
+	fmt.Printf("   time.Duration(retries) * time.Second  → %v   (int * Duration needs a conversion)\n", time.Duration(retries)*time.Second)
+	elapsed := 1234567890 * time.Nanosecond
+	fmt.Printf("   elapsed                               → %v\n", elapsed)
+	fmt.Printf("   elapsed.Round(time.Millisecond)       → %v\n", elapsed.Round(time.Millisecond))
+	fmt.Printf("   elapsed.Truncate(time.Second)         → %v\n", elapsed.Truncate(time.Second))
+	parsed, _ := time.ParseDuration("1h15m30.5s")
+	fmt.Printf("   time.ParseDuration(\"1h15m30.5s\")      → %v (%.0f seconds)\n", parsed, parsed.Seconds())
+	if _, err := time.ParseDuration("2 days"); err != nil {
+		fmt.Printf("   time.ParseDuration(\"2 days\")          → ❌ %v\n", err)
+	}
+	fmt.Printf("\n   launch.Add(36 * time.Hour)            → %v\n", launch.Add(36*time.Hour))
+	deadline := time.Date(2009, time.December, 25, 0, 0, 0, 0, time.UTC)
+	fmt.Printf("   deadline.Sub(launch)                  → %v\n", deadline.Sub(launch))
+	fmt.Printf("   launch.Before(deadline)               → %t\n", launch.Before(deadline))
+	endOfJanuary := time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC)
+	fmt.Printf("   Jan 31 2023 .AddDate(0, 1, 0)         → %s   ⚠️  Feb 31 normalizes\n",
+		endOfJanuary.AddDate(0, 1, 0).Format(time.DateOnly))
+	fmt.Printf("   💡 Duration is an int64 of nanoseconds - the longest is about 292 years\n\n")
+
+	// Section 4: Formatting
+	printTimeSection("4. Formatting with the Reference Layout")
+	fmt.Printf("   Layouts are written using one fixed moment:\n")
+	fmt.Printf("   Mon Jan 2 15:04:05 MST 2006  (1 2 3 4 5 6 7 - month, day, hour...)\n\n")
+	fmt.Printf("   ┌───────────────────────────────┬──────────────────────────────────┐\n")
+	fmt.Printf("   │ Layout                        │ launch formatted                 │\n")
+	fmt.Printf("   ├───────────────────────────────┼──────────────────────────────────┤\n")
+	for _, layout := range []string{
+		time.RFC3339,
+		time.DateTime,
+		time.DateOnly,
+		time.Kitchen,
+		"Monday, January 2, 2006",
+		"02/01/2006 15:04",
+		"Jan _2 3:04PM",
+		"2006-01-02T15:04:05.000Z07:00",
+		"YYYY-MM-DD",
+	} {
+		fmt.Printf("   │ %-29s │ %-32s │\n", layout, launch.Format(layout))
+	}
+	fmt.Printf("   └───────────────────────────────┴──────────────────────────────────┘\n")
+	fmt.Printf("   ⚠️  \"YYYY-MM-DD\" is not a layout - only the reference values mean\n")
+	fmt.Printf("      anything, everything else is copied as-is\n\n")
+
+	// Section 5: Parsing
+	printTimeSection("5. Parsing")
+	for _, attempt := range []struct{ layout, value string }{
+		{time.RFC3339, "2024-03-10T08:30:00+05:30"},
+		{time.DateTime, "2024-03-10 08:30:00"},
+		{time.DateOnly, "2024-02-30"},
+		{time.DateOnly, "10/03/2024"},
+	} {
+		t, err := time.Parse(attempt.layout, attempt.value)
+		if err != nil {
+			fmt.Printf("   Parse(%-21q, %q)\n   → ❌ %v\n", attempt.layout, attempt.value, err)
+			continue
+		}
+		fmt.Printf("   Parse(%-21q, %q)\n   → %v\n", attempt.layout, attempt.value, t)
+	}
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		fmt.Printf("   ❌ %v\n\n", err)
+	} else {
+		local, _ := time.ParseInLocation(time.DateTime, "2024-03-10 08:30:00", newYork)
+		fmt.Printf("   ParseInLocation(time.DateTime, \"2024-03-10 08:30:00\", newYork)\n   → %v\n", local)
+		fmt.Printf("   💡 Without a zone in the input, Parse assumes UTC - use\n")
+		fmt.Printf("      ParseInLocation for local times\n\n")
+	}
+
+	// Section 6: Time Zones
+	printTimeSection("6. Time Zones")
+	fmt.Printf("   The same instant in different locations (tzdata is embedded):\n\n")
+	meeting := time.Date(2024, time.July, 1, 15, 0, 0, 0, time.UTC)
+	fmt.Printf("   ┌─────────────────────┬──────────────────────────────┬──────────┐\n")
+	fmt.Printf("   │ Location            │ meeting.In(loc)              │ Equal    │\n")
+	fmt.Printf("   ├─────────────────────┼──────────────────────────────┼──────────┤\n")
+	for _, name := range []string{"UTC", "America/New_York", "Europe/London", "Asia/Kolkata", "Australia/Sydney"} {
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			fmt.Printf("   │ %-19s │ ❌ %-25v │          │\n", name, err)
+			continue
+		}
+		there := meeting.In(loc)
+		fmt.Printf("   │ %-19s │ %-28s │ %-8t │\n", name, there.Format("Mon Jan 2 15:04 MST"), there.Equal(meeting))
+	}
+	fmt.Printf("   └─────────────────────┴──────────────────────────────┴──────────┘\n")
+	fixed := time.FixedZone("UTC+3", 3*60*60)
+	fmt.Printf("   time.FixedZone(\"UTC+3\", 3*60*60) → %s\n\n", meeting.In(fixed).Format("15:04 MST"))
+	if newYork != nil {
+		beforeDST := time.Date(2024, time.March, 9, 12, 0, 0, 0, newYork)
+		fmt.Printf("   Crossing the New York DST change on March 10, 2024:\n")
+		fmt.Printf("   noon Mar 9 .Add(24 * time.Hour) → %s\n", beforeDST.Add(24*time.Hour).Format("Jan 2 15:04 MST"))
+		fmt.Printf("   noon Mar 9 .AddDate(0, 0, 1)    → %s\n", beforeDST.AddDate(0, 0, 1).Format("Jan 2 15:04 MST"))
+		fmt.Printf("   💡 Add counts elapsed time; AddDate counts calendar days\n\n")
+	}
+
+	// Section 7: Timers
+	printTimeSection("7. Timers")
+	clock := time.Now()
+	timer := time.NewTimer(30 * time.Millisecond)
+	fired := <-timer.C
+	fmt.Printf("   timer := time.NewTimer(30ms); <-timer.C   → fired after %v\n", fired.Sub(clock).Round(time.Millisecond))
+	timer = time.NewTimer(time.Hour)
+	fmt.Printf("   timer := time.NewTimer(time.Hour); timer.Stop() → %t (stopped before firing)\n", timer.Stop())
+	timer.Reset(10 * time.Millisecond)
+	clock = time.Now()
+	<-timer.C
+	fmt.Printf("   timer.Reset(10ms); <-timer.C               → fired after %v\n", time.Since(clock).Round(time.Millisecond))
+	clock = time.Now()
+	select {
+	case <-slowResult(50 * time.Millisecond):
+		fmt.Printf("   result arrived\n")
+	case <-time.After(20 * time.Millisecond):
+		fmt.Printf("   select with time.After(20ms)               → timed out after %v\n", time.Since(clock).Round(time.Millisecond))
+	}
+	fmt.Printf("   💡 Since Go 1.23 an unreferenced, unstopped Timer is garbage\n")
+	fmt.Printf("      collected, and Stop/Reset never leave a stale value in C\n\n")
+
+	// Section 8: Tickers
+	printTimeSection("8. Tickers")
+	ticker := time.NewTicker(15 * time.Millisecond)
+	clock = time.Now()
+	fmt.Printf("   ticker := time.NewTicker(15ms)\n")
+	for i := 1; i <= 4; i++ {
+		<-ticker.C
+		fmt.Printf("   tick %d at %v\n", i, time.Since(clock).Round(time.Millisecond))
+	}
+	ticker.Reset(40 * time.Millisecond)
+	clock = time.Now()
+	<-ticker.C
+	fmt.Printf("   ticker.Reset(40ms) → next tick after %v\n", time.Since(clock).Round(time.Millisecond))
+	ticker.Stop()
+	fmt.Printf("   ticker.Stop()\n")
+	fmt.Printf("   💡 A slow receiver does not pile up ticks - the Ticker drops\n")
+	fmt.Printf("      them to keep the period steady\n\n")
+
+	// Section 9: AfterFunc
+	printTimeSection("9. time.AfterFunc and Debouncing")
+	var wg sync.WaitGroup
+	wg.Add(1)
+	clock = time.Now()
+	time.AfterFunc(25*time.Millisecond, func() {
+		defer wg.Done()
+		fmt.Printf("   AfterFunc(25ms, f) → f ran on its own goroutine after %v\n", time.Since(clock).Round(time.Millisecond))
+	})
+	wg.Wait()
+	cancelled := time.AfterFunc(time.Hour, func() { fmt.Printf("   never printed\n") })
+	fmt.Printf("   AfterFunc(time.Hour, f).Stop() → %t (f will never run)\n\n", cancelled.Stop())
+
+	fmt.Printf("   A debouncer waits for 30ms of quiet before saving:\n")
+	for _, line := range debounceDemo([]int{0, 10, 20, 80, 90}, 30*time.Millisecond) {
+		fmt.Printf("   %s\n", line)
+	}
+	fmt.Printf("   💡 Each keystroke calls timer.Reset, so only pauses trigger a save\n\n")
+
+	// Section 10: Measuring with defer
+	printTimeSection("10. Measuring Execution Time with defer")
+	fmt.Printf("   func timeTrack(start time.Time, name string) {\n")
+	fmt.Printf("       fmt.Printf(\"%%s took %%v\\n\", name, time.Since(start))\n")
+	fmt.Printf("   }\n\n")
+	fmt.Printf("   func slowSum(n int) int {\n")
+	fmt.Printf("       defer timeTrack(time.Now(), \"slowSum\")   // time.Now() runs NOW\n")
+	fmt.Printf("       ...\n")
+	fmt.Printf("   }\n\n")
+	fmt.Printf("   slowSum(30_000_000):\n")
+	sum := slowSum(30_000_000)
+	fmt.Printf("   → %d\n\n", sum)
+	fmt.Printf("   The broken version evaluates time.Since too early:\n")
+	fmt.Printf("   defer fmt.Printf(\"took %%v\", time.Since(start))   // Since runs at the defer line\n")
+	brokenTiming()
+	fmt.Printf("   💡 Deferred arguments are evaluated immediately (Defer tutorial,\n")
+	fmt.Printf("      section 4) - that is what makes timeTrack(time.Now()) work\n")
+	fmt.Printf("   💡 go vet reports \"call to time.Since is not deferred\" for this bug\n\n")
+
+	printTimeFooter()
+}
+
+// Helper functions for demonstrations
+
+func monotonicPart(t time.Time) string {
+	s := t.String()
+	if i := strings.Index(s, " m="); i >= 0 {
+		return s[i+1:]
+	}
+	return ""
+}
+
+func slowResult(delay time.Duration) <-chan string {
+	result := make(chan string, 1)
+	time.AfterFunc(delay, func() { result <- "done" })
+	return result
+}
+
+// debounceDemo replays keystrokes at the given offsets (in milliseconds)
+// through a timer that saves after quiet of the given length.
+func debounceDemo(offsets []int, quiet time.Duration) []string {
+	var mu sync.Mutex
+	var lines []string
+	record := func(start time.Time, format string, args ...any) {
+		mu.Lock()
+		defer mu.Unlock()
+		at := time.Since(start).Round(5 * time.Millisecond)
+		lines = append(lines, fmt.Sprintf("%4dms  ", at.Milliseconds())+fmt.Sprintf(format, args...))
+	}
+
+	start := time.Now()
+	saves := 0
+	var wg sync.WaitGroup
+	var timer *time.Timer
+	for i, offset := range offsets {
+		time.Sleep(time.Until(start.Add(time.Duration(offset) * time.Millisecond)))
+		record(start, "keystroke %d", i+1)
+		if timer != nil && timer.Stop() {
+			timer.Reset(quiet)
+		} else {
+			wg.Add(1)
+			timer = time.AfterFunc(quiet, func() {
+				defer wg.Done()
+				mu.Lock()
+				saves++
+				n := saves
+				mu.Unlock()
+				record(start, "💾 save #%d", n)
+			})
+		}
+	}
+	wg.Wait()
+	return lines
+}
+
+func timeTrack(start time.Time, name string) {
+	fmt.Printf("   ⏱️  %s took %v\n", name, time.Since(start).Round(time.Millisecond))
+}
+
+func slowSum(n int) int {
+	defer timeTrack(time.Now(), "slowSum")
+	total := 0
+	for i := range n {
+		total += i % 7
+	}
+	return total
+}
+
+// sinceStart hides the time.Since call from go vet's defers check, which
+// would otherwise reject the deliberately broken example below.
+func sinceStart(start time.Time) time.Duration {
+	return time.Since(start).Round(time.Millisecond)
+}
+
+func brokenTiming() {
+	start := time.Now()
+	defer fmt.Printf("   ⏱️  brokenTiming took %v   ← wrong, the real work took ~20ms\n", sinceStart(start))
+	time.Sleep(20 * time.Millisecond)
+}
+
+// Print helper functions
+
+func printTimeHeader(title string) {
+	fmt.Println("\n" + strings.Repeat("=", 60))
+	fmt.Printf("  %s\n", title)
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
+
+func printTimeSection(title string) {
+	fmt.Printf("┌─ %s\n", title)
+	fmt.Println("│")
+}
+
+func printTimeFooter() {
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println("  ✅ Tutorial Complete!")
+	fmt.Println("  💡 Key Takeaways:")
+	fmt.Println("     • time.Now() holds a wall and a monotonic reading")
+	fmt.Println("     • Durations are nanosecond int64s with handy constants")
+	fmt.Println("     • Layouts are spelled with Mon Jan 2 15:04:05 MST 2006")
+	fmt.Println("     • Add counts elapsed time, AddDate counts calendar days")
+	fmt.Println("     • Stop timers and tickers you no longer need")
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
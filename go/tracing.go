@@ -0,0 +1,444 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	stdmaps "maps"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/trace"
+	stdslices "slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const traceColumns = 60
+
+func tracing() {
+	printTraceHeader("GO EXECUTION TRACER TUTORIAL")
+
+	// Section 1: Recording a Trace
+	printTraceSection("1. Recording a Trace")
+	fmt.Printf("   A CPU profile samples stacks; an execution trace records every\n")
+	fmt.Printf("   scheduler event - which goroutine ran on which P, and when.\n\n")
+	fmt.Printf("   f, err := os.Create(filepath.Join(dir, \"trace.out\"))\n")
+	fmt.Printf("   trace.Start(f)\n")
+	fmt.Printf("   traceWorkload()\n")
+	fmt.Printf("   trace.Stop()\n\n")
+	dir, err := os.MkdirTemp("", "trace-demo-")
+	if err != nil {
+		fmt.Printf("   ❌ could not create temp dir: %v\n\n", err)
+		printTraceFooter()
+		return
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "trace.out")
+	previous := runtime.GOMAXPROCS(2)
+	err = recordTrace(path, traceWorkload)
+	runtime.GOMAXPROCS(previous)
+	if err != nil {
+		fmt.Printf("   ❌ trace failed: %v\n\n", err)
+		printTraceFooter()
+		return
+	}
+	if info, err := os.Stat(path); err == nil {
+		fmt.Printf("   Recorded %s (%d bytes) with runtime.GOMAXPROCS(2)\n", filepath.Base(path), info.Size())
+	}
+	fmt.Printf("   💡 A P is a logical processor: a goroutine needs one to run.\n")
+	fmt.Printf("      This machine has %d CPU(s), but the lesson asks for two Ps\n\n", runtime.NumCPU())
+
+	// Section 2: The Traced Program
+	printTraceSection("2. The Traced Program")
+	fmt.Printf("   results := make(chan int)\n")
+	fmt.Printf("   wg.Go(func() { spin(4ms); results <- 1 })                // A\n")
+	fmt.Printf("   wg.Go(func() { spin(3ms); results <- 2 })                // B\n")
+	fmt.Printf("   wg.Go(func() { spin(2ms); time.Sleep(3ms); spin(2ms) })  // C\n")
+	fmt.Printf("   wg.Go(func() { <-results; <-results; spin(1ms) })        // D\n")
+	fmt.Printf("   wg.Wait()                                                // main\n\n")
+	fmt.Printf("   Each goroutine calls trace.Log(ctx, \"lesson\", name) first, so\n")
+	fmt.Printf("   the trace can tell them apart. spin busy-loops on the CPU.\n\n")
+
+	// Section 3: Reading the Events
+	printTraceSection("3. Reading the Events")
+	fmt.Printf("   $ go tool trace -d=parsed %s\n", filepath.Base(path))
+	dump, err := runGoTool(dir, "tool", "trace", "-d=parsed", path)
+	if err != nil {
+		fmt.Printf("   ❌ go tool trace failed: %v\n", err)
+		printToolOutput(dump)
+		fmt.Println()
+		printTraceFooter()
+		return
+	}
+	timeline := parseTraceDump(dump)
+	kinds := stdslices.SortedFunc(stdmaps.Keys(timeline.events), func(a, b string) int {
+		return cmp.Or(cmp.Compare(timeline.events[b], timeline.events[a]), strings.Compare(a, b))
+	})
+	for _, kind := range kinds {
+		fmt.Printf("      %-16s ×%d\n", kind, timeline.events[kind])
+	}
+	if id, ok := timeline.labelled("A"); ok {
+		fmt.Printf("\n   Goroutine A (G%d) in the dump:\n", id)
+		shown := 0
+		for _, line := range strings.Split(dump, "\n") {
+			if shown == 4 {
+				break
+			}
+			if strings.Contains(line, "StateTransition") && strings.Contains(line, " GoID="+strconv.Itoa(id)+" ") {
+				_, rest, _ := strings.Cut(line, " ")
+				fmt.Printf("      %s\n", rest)
+				shown++
+			}
+		}
+	}
+	fmt.Printf("\n   💡 The lesson parses these lines itself: every StateTransition\n")
+	fmt.Printf("      moves one goroutine between Runnable, Running and Waiting\n\n")
+
+	if timeline.end <= timeline.start {
+		fmt.Printf("   ⚠️  the lesson markers were not found in the trace\n\n")
+		printTraceFooter()
+		return
+	}
+	symbols := map[string]string{"main": "m", "A": "A", "B": "B", "C": "C", "D": "D"}
+	order := []string{"main", "A", "B", "C", "D"}
+
+	// Section 4: Who Ran on Each P
+	printTraceSection("4. Who Ran on Each P")
+	fmt.Printf("   %s of the lesson, %d columns ≈ %v each\n\n",
+		formatTraceDuration(timeline.end-timeline.start), traceColumns,
+		formatTraceDuration((timeline.end-timeline.start)/traceColumns))
+	for p := range timeline.procs {
+		fmt.Printf("   P%d │%s│\n", p, timeline.procRow(p, symbols))
+	}
+	fmt.Printf("      0%s%s\n", strings.Repeat(" ", traceColumns-1), formatTraceDuration(timeline.end-timeline.start))
+	fmt.Printf("   m = main, A-D = workers, ▪ = runtime goroutines, · = idle P\n\n")
+
+	// Section 5: Goroutine States
+	printTraceSection("5. Goroutine States Over Time")
+	for _, label := range order {
+		if id, ok := timeline.labelled(label); ok {
+			fmt.Printf("   %-4s │%s│\n", label, timeline.stateRow(id))
+		}
+	}
+	fmt.Printf("   █ running   ░ runnable (waiting for a P)   ─ waiting   (blank) not alive\n\n")
+	fmt.Printf("   ┌───────────┬────────────┬────────────┬────────────┬──────────────────────┐\n")
+	fmt.Printf("   │ Goroutine │ Running    │ Runnable   │ Waiting    │ Waited on            │\n")
+	fmt.Printf("   ├───────────┼────────────┼────────────┼────────────┼──────────────────────┤\n")
+	var mostRunnable string
+	var mostRunnableTime int64
+	for _, label := range order {
+		id, ok := timeline.labelled(label)
+		if !ok {
+			continue
+		}
+		totals := timeline.totals(id)
+		if totals.runnable > mostRunnableTime && label != "main" {
+			mostRunnable, mostRunnableTime = label, totals.runnable
+		}
+		fmt.Printf("   │ %-9s │ %-10s │ %-10s │ %-10s │ %-20s │\n", label,
+			formatTraceDuration(totals.running), formatTraceDuration(totals.runnable),
+			formatTraceDuration(totals.waiting), totals.reasons())
+	}
+	fmt.Printf("   └───────────┴────────────┴────────────┴────────────┴──────────────────────┘\n")
+	if mostRunnable != "" {
+		fmt.Printf("   💡 %s sat Runnable for %s: ready to go, but both Ps were busy\n",
+			mostRunnable, formatTraceDuration(mostRunnableTime))
+	}
+	fmt.Printf("   💡 Waiting goroutines cost no CPU - D parks on the channel until\n")
+	fmt.Printf("      A and B send, and C's Sleep frees its P for someone else\n\n")
+
+	// Section 6: Beyond the Terminal
+	printTraceSection("6. Beyond the Terminal")
+	fmt.Printf("   go test -trace=trace.out ./...      // trace a test run\n")
+	fmt.Printf("   go tool trace trace.out             // full timeline in a browser\n")
+	fmt.Printf("   ctx, task := trace.NewTask(ctx, \"checkout\")\n")
+	fmt.Printf("   trace.WithRegion(ctx, \"charge card\", charge)   // named spans\n\n")
+	fmt.Printf("   ✅ Traces answer \"why is this slow\" when the CPU profile looks\n")
+	fmt.Printf("      idle: blocking, lock contention and scheduler latency\n\n")
+
+	printTraceFooter()
+}
+
+// Helper functions for demonstrations
+
+func recordTrace(path string, workload func()) (err error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+	if err := trace.Start(f); err != nil {
+		return err
+	}
+	workload()
+	trace.Stop()
+	return nil
+}
+
+func traceWorkload() {
+	ctx := context.Background()
+	trace.Log(ctx, "lesson", "main")
+	var wg sync.WaitGroup
+	results := make(chan int)
+	wg.Go(func() {
+		trace.Log(ctx, "lesson", "A")
+		spin(4 * time.Millisecond)
+		results <- 1
+	})
+	wg.Go(func() {
+		trace.Log(ctx, "lesson", "B")
+		spin(3 * time.Millisecond)
+		results <- 2
+	})
+	wg.Go(func() {
+		trace.Log(ctx, "lesson", "C")
+		spin(2 * time.Millisecond)
+		time.Sleep(3 * time.Millisecond)
+		spin(2 * time.Millisecond)
+	})
+	wg.Go(func() {
+		trace.Log(ctx, "lesson", "D")
+		<-results
+		<-results
+		spin(time.Millisecond)
+	})
+	wg.Wait()
+	trace.Log(ctx, "lesson", "done")
+}
+
+// spin keeps the CPU busy instead of sleeping, so the goroutine holds
+// its P the whole time.
+func spin(d time.Duration) {
+	for start := time.Now(); time.Since(start) < d; {
+	}
+}
+
+type traceSpan struct {
+	state      string
+	p          int
+	reason     string
+	start, end int64
+}
+
+type traceGoroutine struct {
+	label  string
+	state  string
+	p      int
+	reason string
+	since  int64
+	spans  []traceSpan
+}
+
+type traceTimeline struct {
+	start, end int64
+	procs      int
+	events     map[string]int
+	goroutines map[int]*traceGoroutine
+}
+
+type traceTotals struct {
+	running, runnable, waiting int64
+	waitedOn                   map[string]int64
+}
+
+// parseTraceDump rebuilds each goroutine's state history from the text
+// printed by `go tool trace -d=parsed`. Lines look like:
+//
+//	M=14917 P=1 G=-1 StateTransition Time=2841501389760 GoID=23 Runnable->Running Reason=""
+func parseTraceDump(dump string) *traceTimeline {
+	tl := &traceTimeline{events: make(map[string]int), goroutines: make(map[int]*traceGoroutine)}
+	goroutine := func(id int) *traceGoroutine {
+		g, ok := tl.goroutines[id]
+		if !ok {
+			g = &traceGoroutine{state: "Undetermined"}
+			tl.goroutines[id] = g
+		}
+		return g
+	}
+	for _, line := range strings.Split(dump, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 5 || !strings.HasPrefix(line, "M=") {
+			continue
+		}
+		kind := fields[3]
+		tl.events[kind]++
+		t, _ := strconv.ParseInt(traceField(line, "Time"), 10, 64)
+		p, _ := strconv.Atoi(traceField(line, "P"))
+		switch kind {
+		case "Log":
+			if traceField(line, "Category") != "lesson" {
+				continue
+			}
+			g, _ := strconv.Atoi(traceField(line, "G"))
+			switch label := traceField(line, "Message"); label {
+			case "main":
+				tl.start = t
+				goroutine(g).label = label
+			case "done":
+				tl.end = t
+			default:
+				goroutine(g).label = label
+			}
+		case "StateTransition":
+			id, err := strconv.Atoi(traceField(line, "GoID"))
+			if err != nil || len(fields) < 7 {
+				continue
+			}
+			from, to, ok := strings.Cut(fields[6], "->")
+			if !ok {
+				continue
+			}
+			g := goroutine(id)
+			if g.state == from && from != "NotExist" && from != "Undetermined" {
+				g.spans = append(g.spans, traceSpan{state: from, p: g.p, reason: g.reason, start: g.since, end: t})
+			}
+			g.state, g.p, g.reason, g.since = to, p, traceField(line, "Reason"), t
+			if to == "Running" && p >= tl.procs {
+				tl.procs = p + 1
+			}
+		}
+	}
+	return tl
+}
+
+// traceField returns the value of key=value in a dump line, unquoting
+// values such as Reason="chan receive".
+func traceField(line, key string) string {
+	i := strings.Index(" "+line, " "+key+"=")
+	if i < 0 {
+		return ""
+	}
+	rest := line[i+len(key)+1:]
+	if strings.HasPrefix(rest, `"`) {
+		if value, _, ok := strings.Cut(rest[1:], `"`); ok {
+			return value
+		}
+	}
+	value, _, _ := strings.Cut(rest, " ")
+	return value
+}
+
+func (tl *traceTimeline) labelled(label string) (int, bool) {
+	for id, g := range tl.goroutines {
+		if g.label == label {
+			return id, true
+		}
+	}
+	return 0, false
+}
+
+// overlap is how much of span s falls inside column i of the timeline.
+func (tl *traceTimeline) overlap(s traceSpan, i int) int64 {
+	width := tl.end - tl.start
+	lo := tl.start + width*int64(i)/traceColumns
+	hi := tl.start + width*int64(i+1)/traceColumns
+	return max(0, min(s.end, hi)-max(s.start, lo))
+}
+
+func (tl *traceTimeline) procRow(p int, symbols map[string]string) string {
+	var row strings.Builder
+	for i := range traceColumns {
+		best, symbol := int64(0), "·"
+		for _, g := range tl.goroutines {
+			var busy int64
+			for _, s := range g.spans {
+				if s.state == "Running" && s.p == p {
+					busy += tl.overlap(s, i)
+				}
+			}
+			if busy > best {
+				best, symbol = busy, cmp.Or(symbols[g.label], "▪")
+			}
+		}
+		row.WriteString(symbol)
+	}
+	return row.String()
+}
+
+func (tl *traceTimeline) stateRow(id int) string {
+	glyphs := map[string]string{"Running": "█", "Runnable": "░", "Waiting": "─", "Syscall": "─"}
+	var row strings.Builder
+	for i := range traceColumns {
+		spent := make(map[string]int64)
+		for _, s := range tl.goroutines[id].spans {
+			spent[glyphs[s.state]] += tl.overlap(s, i)
+		}
+		best, glyph := int64(0), " "
+		for _, g := range []string{"█", "░", "─"} {
+			if spent[g] > best {
+				best, glyph = spent[g], g
+			}
+		}
+		row.WriteString(glyph)
+	}
+	return row.String()
+}
+
+func (tl *traceTimeline) totals(id int) traceTotals {
+	totals := traceTotals{waitedOn: make(map[string]int64)}
+	for _, s := range tl.goroutines[id].spans {
+		d := max(0, min(s.end, tl.end)-max(s.start, tl.start))
+		switch s.state {
+		case "Running":
+			totals.running += d
+		case "Runnable":
+			totals.runnable += d
+		case "Waiting", "Syscall":
+			totals.waiting += d
+			if d > 0 {
+				totals.waitedOn[cmp.Or(s.reason, strings.ToLower(s.state))] += d
+			}
+		}
+	}
+	return totals
+}
+
+func (t traceTotals) reasons() string {
+	reasons := stdslices.SortedFunc(stdmaps.Keys(t.waitedOn), func(a, b string) int {
+		return cmp.Compare(t.waitedOn[b], t.waitedOn[a])
+	})
+	if len(reasons) == 0 {
+		return "-"
+	}
+	text := strings.Join(reasons, ", ")
+	if len(text) > 20 {
+		text = text[:19] + "…"
+	}
+	return text
+}
+
+func formatTraceDuration(ns int64) string {
+	return time.Duration(ns).Round(10 * time.Microsecond).String()
+}
+
+// Print helper functions
+
+func printTraceHeader(title string) {
+	fmt.Println("\n" + strings.Repeat("=", 60))
+	fmt.Printf("  %s\n", title)
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}
+
+func printTraceSection(title string) {
+	fmt.Printf("┌─ %s\n", title)
+	fmt.Println("│")
+}
+
+func printTraceFooter() {
+	fmt.Println(strings.Repeat("=", 60))
+	fmt.Println("  ✅ Tutorial Complete!")
+	fmt.Println("  💡 Key Takeaways:")
+	fmt.Println("     • runtime/trace records every scheduler event")
+	fmt.Println("     • Goroutines move between Runnable, Running and Waiting")
+	fmt.Println("     • A goroutine needs a P to run; GOMAXPROCS sets how many")
+	fmt.Println("     • Runnable time is scheduler latency, not work")
+	fmt.Println("     • Use traces for blocking; use profiles for CPU hotspots")
+	fmt.Println(strings.Repeat("=", 60) + "\n")
+}